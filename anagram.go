@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"wordtl/words"
+)
+
+func Anagram(solutionWords []string) {
+	letters := strings.ToLower(strings.TrimSpace(Letters))
+	if len(letters) == 0 {
+		fmt.Printf("\nERROR: -%s is required, see usage with '%s %s -h'\n\n", LettersFlag, os.Args[0], Mode)
+		os.Exit(1)
+	}
+	for _, letter := range letters {
+		if !strings.ContainsRune(words.Alphabet+words.BlankChar, letter) {
+			fmt.Printf("\nERROR: -%s can only include the letters a-z and '%s' for a blank. '%s' is not valid.\n\n", LettersFlag, words.BlankChar, string(letter))
+			os.Exit(1)
+		}
+	}
+	fmt.Printf("Anagram letters: '%s'\n", letters)
+	if UseAllLetters {
+		fmt.Println("Using all letters.")
+	}
+
+	anagramWords := words.GetAnagramWords(solutionWords, letters, UseAllLetters)
+	if len(anagramWords) == 0 {
+		fmt.Println()
+		fmt.Println("NO MATCHING WORDS. Please change args to get matching results.")
+		fmt.Println()
+		return
+	}
+
+	// Longest words first since they use the most letters.
+	wordsByLength := map[int][]string{}
+	for _, word := range anagramWords {
+		wordsByLength[len(word)] = append(wordsByLength[len(word)], word)
+	}
+	lengths := make([]int, 0, len(wordsByLength))
+	for length := range wordsByLength {
+		lengths = append(lengths, length)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(lengths)))
+
+	for _, length := range lengths {
+		printWords(wordsByLength[length], fmt.Sprintf("ANAGRAM %s WORDS WITH %d LETTERS", getWordSearchTitle(), length), "EXACT MATCH", MaxWordsToPrint)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"wordtl/words"
+
+	"github.com/gookit/color"
+)
+
+const BeeLetters = 7
+
+func getBeeLettersAndCenter() (string, string) {
+	centerLetter := strings.ToLower(strings.TrimSpace(CenterLetter))
+	if len(centerLetter) != 1 || !strings.Contains(words.Alphabet, centerLetter) {
+		fmt.Printf("\nERROR: -%s must be a single letter, see usage with '%s %s -h'\n\n", CenterFlag, os.Args[0], Mode)
+		os.Exit(1)
+	}
+
+	// The center letter does not have to be repeated in the other letters.
+	letters := centerLetter
+	for _, letter := range strings.ToLower(Letters) {
+		if !strings.ContainsRune(words.Alphabet, letter) {
+			fmt.Printf("\nERROR: -%s can only include the letters a-z. '%s' is not valid.\n\n", LettersFlag, string(letter))
+			os.Exit(1)
+		}
+		if !strings.ContainsRune(letters, letter) {
+			letters += string(letter)
+		}
+	}
+	if len(letters) != BeeLetters {
+		fmt.Printf("\nERROR: -%s and -%s must be %d different letters. '%s' is %d letters.\n\n", LettersFlag, CenterFlag, BeeLetters, letters, len(letters))
+		os.Exit(1)
+	}
+
+	return letters, centerLetter
+}
+
+func Bee(solutionWords []string) {
+	letters, centerLetter := getBeeLettersAndCenter()
+	fmt.Printf("Bee letters: '%s'\n", letters)
+	fmt.Printf("Center letter: '%s'\n", centerLetter)
+
+	beeWords := words.GetBeeWords(solutionWords, letters, centerLetter)
+	if PatternRegexp != nil {
+		beeWords = words.GetRegexMatchingWords(beeWords, PatternRegexp)
+	}
+
+	wordsByLength := map[int][]string{}
+	for _, word := range beeWords {
+		wordsByLength[len(word)] = append(wordsByLength[len(word)], word)
+	}
+	lengths := make([]int, 0, len(wordsByLength))
+	for length := range wordsByLength {
+		lengths = append(lengths, length)
+	}
+	sort.Ints(lengths)
+
+	// Filter each word length separately like the search subcommand, so the other search flags can be used too. Every
+	// matching word is kept, so the totals and pangrams are for all of them.
+	totalWords := 0
+	for _, length := range lengths {
+		wordPattern := WordPattern
+		if len(wordPattern) != length {
+			wordPattern = strings.Repeat(words.WildcardChar, length)
+		}
+		wordsByLength[length] = words.GetMatchingWords(wordsByLength[length], wordPattern, ExcludedLetters, WildcardLetters, true, ExcludedByPosMap)
+		totalWords += len(wordsByLength[length])
+	}
+	if totalWords == 0 {
+		fmt.Println()
+		fmt.Println("NO MATCHING WORDS. Please change args to get matching results.")
+		fmt.Println()
+		return
+	}
+
+	pangram := color.New(color.FgGreen, color.Bold)
+	highlightPangram := func(word string) string {
+		if words.IsPangram(word, letters) {
+			// Upper case so pangrams stand out without color too.
+			return pangram.Sprint(strings.ToUpper(word))
+		}
+		return word
+	}
+
+	totalPoints := 0
+	pangrams := []string{}
+	for _, length := range lengths {
+		lengthWords := wordsByLength[length]
+		if len(lengthWords) == 0 {
+			continue
+		}
+		points := 0
+		for _, word := range lengthWords {
+			points += words.GetBeeScore(word, letters)
+			if words.IsPangram(word, letters) {
+				pangrams = append(pangrams, word)
+			}
+		}
+		totalPoints += points
+		printHighlightedWords(lengthWords, fmt.Sprintf("BEE %s WORDS WITH %d LETTERS, %d POINTS", getWordSearchTitle(), length, points), "", MaxWordsToPrint, highlightPangram)
+	}
+
+	fmt.Println()
+	fmt.Printf("TOTAL: %d words, %d points\n", totalWords, totalPoints)
+	printWords(pangrams, "PANGRAMS", "", MaxWordsToPrint)
+	fmt.Println()
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"wordtl/words"
+)
+
+func LetterBoxed(solutionWords []string) {
+	sides, err := words.ParseLetterBoxSides(LetterBoxSides)
+	if err != nil {
+		fmt.Println("Invalid -" + SidesFlag + " '" + LetterBoxSides + "': " + err.Error() + ", see usage with '" + os.Args[0] + " " + Mode + " -h'")
+		os.Exit(1)
+	}
+	fmt.Printf("Letter Boxed sides: '%s'\n", strings.Join(sides, words.LetterBoxSideSeparator))
+
+	letterBoxWords := words.GetLetterBoxWords(solutionWords, sides)
+	printWords(letterBoxWords, "LETTER BOXED "+getWordSearchTitle()+" WORDS", "", MaxWordsToPrint)
+	if len(letterBoxWords) == 0 {
+		fmt.Println()
+		return
+	}
+
+	solutions := words.GetLetterBoxSolutions(letterBoxWords, sides, MaxChainWords, MaxWordsToPrint)
+	if len(solutions) == 0 {
+		fmt.Println()
+		fmt.Printf("NO SOLUTIONS with %d words or less. Please change args to get matching results.\n", MaxChainWords)
+		fmt.Println()
+		return
+	}
+
+	fmt.Printf("\nSHORTEST SOLUTIONS WITH %d WORDS (%d):\n", len(solutions[0]), len(solutions))
+	for _, solution := range solutions {
+		fmt.Println(strings.Join(solution, " - "))
+	}
+	fmt.Println()
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"wordtl/words"
+)
+
+const (
+	ConfigDirName  = "wordtl"
+	ConfigFileName = "profiles.json"
+)
+
+// getConfigFile returns the path of the file with the profiles in the user's config directory.
+func getConfigFile() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, ConfigDirName, ConfigFileName), nil
+}
+
+// loadProfile reads the flag values of the named profile from the config file. There are no values when no profile is
+// named.
+func loadProfile(name string) map[string]string {
+	if len(name) == 0 {
+		return map[string]string{}
+	}
+
+	configFile, err := getConfigFile()
+	if err == nil {
+		var config []byte
+		if config, err = os.ReadFile(configFile); err == nil {
+			var profiles map[string]map[string]string
+			if profiles, err = words.ParseProfiles(config); err == nil {
+				profile, found := profiles[name]
+				if !found {
+					fmt.Printf("\nERROR: Profile '%s' is not in '%s'. Available profiles: '%s'.\n\n", name, configFile, strings.Join(words.GetProfileNames(profiles), "', '"))
+					os.Exit(1)
+				}
+				return profile
+			}
+		}
+	}
+	fmt.Printf("\nERROR: Can't read profile '%s' from '%s': %s\n\n", name, configFile, err.Error())
+	os.Exit(1)
+	return nil
+}
+
+// getArgValue finds the value of a flag in the command line args before they are parsed, such as '-name value' or
+// '--name=value'.
+func getArgValue(args []string, name string) (string, bool) {
+	for i, arg := range args {
+		argName := strings.TrimLeft(arg, "-")
+		if argName == arg || len(arg)-len(argName) > 2 {
+			continue
+		}
+		if strings.HasPrefix(argName, name+"=") {
+			return strings.TrimPrefix(argName, name+"="), true
+		} else if argName == name && i+1 < len(args) {
+			return args[i+1], true
+		}
+	}
+	return "", false
+}
+
+// applyProfile sets each flag in the profile that was not set on the command line. Flags that belong to other
+// subcommands are skipped, so one profile can be used with several subcommands.
+func applyProfile(cmd *flag.FlagSet, profile map[string]string, subcommands map[string]*flag.FlagSet) {
+	names := make([]string, 0, len(profile))
+	for name := range profile {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if isFlagSet(cmd, name) {
+			continue
+		}
+		if cmd.Lookup(name) == nil {
+			isKnownFlag := false
+			for _, fs := range subcommands {
+				isKnownFlag = isKnownFlag || fs.Lookup(name) != nil
+			}
+			if !isKnownFlag {
+				fmt.Printf("\nERROR: Profile '%s' has '%s', which is not a flag of any subcommand.\n\n", Profile, name)
+				os.Exit(1)
+			}
+			continue
+		}
+		if err := cmd.Set(name, profile[name]); err != nil {
+			fmt.Println("Invalid -" + name + " in profile '" + Profile + "': " + err.Error() + ", see usage with '" + os.Args[0] + " " + cmd.Name() + " -h'")
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"wordtl/words"
+)
+
+func getGuessesAndResults() ([]string, []string) {
+	guesses := splitCommaList(strings.ToLower(Guesses))
+	results := splitCommaList(strings.ToLower(Results))
+	if len(guesses) != len(results) {
+		fmt.Printf("\nERROR: There must be a result in -%s for each guess in -%s. There are %d guesses and %d results.\n\n", ResultsFlag, GuessesFlag, len(guesses), len(results))
+		os.Exit(1)
+	}
+
+	for i, guess := range guesses {
+		if len(guess) != WordLength {
+			fmt.Printf("\nERROR: Guess must be %d letters long. '%s' is %d lettters.\n\n", WordLength, guess, len(guess))
+			os.Exit(1)
+		}
+		fmt.Printf("TRY #%d:\n", i+1)
+		if !printWordleResult(guess, results[i]) {
+			os.Exit(1)
+		}
+	}
+	return guesses, results
+}
+
+// Fibble solves Fibble, where each result has a number of tiles with the wrong colour.
+func Fibble(solutionWords []string, allWords []string) {
+	if Lies < 0 || Lies > WordLength {
+		fmt.Printf("\nERROR: -%s must be between 0 and %d. Entered lies is %d.\n\n", LiesFlag, WordLength, Lies)
+		os.Exit(1)
+	}
+	fmt.Printf("Lies per result: %d\n", Lies)
+	guesses, results := getGuessesAndResults()
+
+	matchingWords := words.GetFibbleMatchingWords(solutionWords, guesses, results, Lies)
+	printWords(matchingWords, "MATCHING WORDS", "SOLUTION", MaxWordsToPrint)
+	if len(matchingWords) <= 1 {
+		fmt.Println()
+		return
+	}
+
+	bestGuesses, expectedRemaining := words.GetBestFibbleGuesses(matchingWords, getGuessWords(matchingWords, allWords), Lies)
+	printBestGuesses(bestGuesses, expectedRemaining)
+}
@@ -2,4 +2,8 @@ module wordtl
 
 go 1.16
 
-require github.com/gookit/color v1.5.2
+require (
+	github.com/gdamore/tcell/v2 v2.5.4
+	github.com/gookit/color v1.5.2
+	golang.org/x/term v0.0.0-20210927222741-03fcf44c2211
+)
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"wordtl/words"
+)
+
+const MaxHangmanLettersToPrint = 10
+
+func Hangman(solutionWords []string) {
+	if HangmanSelfPlay {
+		HangmanSelfPlayReport(solutionWords)
+		return
+	}
+
+	matchingWords := words.GetHangmanWords(solutionWords, WordPattern, ExcludedLetters)
+	printWords(matchingWords, "MATCHING WORDS", "EXACT MATCH", MaxWordsToPrint)
+	if len(matchingWords) == 0 {
+		fmt.Println()
+		return
+	}
+
+	hangmanLetters := words.GetHangmanLetters(matchingWords, WordPattern+ExcludedLetters)
+	if len(hangmanLetters) == 0 {
+		fmt.Println()
+		return
+	}
+	fmt.Println()
+	fmt.Println("Letters to try:")
+	fmt.Println("Letter  Information  In Word")
+	for i, hangmanLetter := range hangmanLetters {
+		if i == MaxHangmanLettersToPrint {
+			break
+		}
+		fmt.Printf("  %s     %5.2f bits    %3.0f%%\n", hangmanLetter.Letter, hangmanLetter.Information, hangmanLetter.HitRate*100)
+	}
+	fmt.Println()
+	fmt.Printf("BEST LETTER: '%s'\n", hangmanLetters[0].Letter)
+	fmt.Println()
+}
+
+// HangmanSelfPlayReport plays a game of hangman for every word and reports how many letters were missed.
+func HangmanSelfPlayReport(solutionWords []string) {
+	fmt.Printf("Playing hangman for %d words.\n", len(solutionWords))
+
+	bestLetters := map[string]string{}
+	missesCount := map[int]int{}
+	totalMisses := 0
+	lostWords := []string{}
+	for _, answer := range solutionWords {
+		misses := len(words.PlayHangman(answer, solutionWords, bestLetters))
+		missesCount[misses]++
+		totalMisses += misses
+		if misses >= MaxMisses {
+			lostWords = append(lostWords, answer)
+		}
+	}
+
+	fmt.Println()
+	fmt.Println("Misses  Words")
+	misses := make([]int, 0, len(missesCount))
+	for miss := range missesCount {
+		misses = append(misses, miss)
+	}
+	sort.Ints(misses)
+	for _, miss := range misses {
+		fmt.Printf("%6d  %d\n", miss, missesCount[miss])
+	}
+	fmt.Println()
+	fmt.Printf("Average misses per word: %.2f\n", float64(totalMisses)/float64(len(solutionWords)))
+	fmt.Printf("Words lost with %d or more misses: %d\n", MaxMisses, len(lostWords))
+	if len(lostWords) > 0 {
+		printWords(lostWords, "LOST WORDS", "", MaxWordsToPrint)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"wordtl/words"
+)
+
+// MaxScoredGuessChecks limits how many guess and candidate pairs are scored to suggest the next guess. When there
+// are more, only the candidates are used as guesses.
+const MaxScoredGuessChecks = 5000000
+
+const MaxScoredGuessesToPrint = 10
+
+func getScoredGuessesAndScores() ([]string, []string) {
+	guesses := splitCommaList(strings.ToLower(Guesses))
+	scores := splitCommaList(Scores)
+	if len(guesses) != len(scores) {
+		fmt.Printf("\nERROR: There must be a score in -%s for each guess in -%s. There are %d guesses and %d scores.\n\n", ScoresFlag, GuessesFlag, len(guesses), len(scores))
+		os.Exit(1)
+	}
+
+	for i, guess := range guesses {
+		if len(guess) != WordLength {
+			fmt.Printf("\nERROR: Guess must be %d letters long. '%s' is %d lettters.\n\n", WordLength, guess, len(guess))
+			os.Exit(1)
+		}
+		score, err := words.ParseScore(scores[i], WordLength, UseBullsAndCows)
+		if err != nil {
+			fmt.Printf("\nERROR: Score '%s' for '%s' %s.\n\n", scores[i], guess, err)
+			os.Exit(1)
+		}
+		scores[i] = score
+		fmt.Printf("TRY #%d: '%s' scored %s\n", i+1, guess, scores[i])
+	}
+	return guesses, scores
+}
+
+// Jotto solves Jotto and Bulls and Cows, where each guess only gets a count of the letters that matched.
+func Jotto(solutionWords []string, allWords []string) {
+	scorer := words.Scorer(words.ScoreJotto)
+	if UseBullsAndCows {
+		scorer = words.ScoreBullsAndCows
+	}
+	guesses, scores := getScoredGuessesAndScores()
+
+	matchingWords := words.GetScoredMatchingWords(solutionWords, guesses, scores, scorer)
+	printWords(matchingWords, "MATCHING WORDS", "SOLUTION", MaxWordsToPrint)
+	if len(matchingWords) <= 1 {
+		fmt.Println()
+		return
+	}
+
+	bestGuesses, expectedRemaining := words.GetBestScoredGuesses(matchingWords, getGuessWords(matchingWords, allWords), scorer)
+	printBestGuesses(bestGuesses, expectedRemaining)
+}
+
+func splitCommaList(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) > 0 {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// getGuessWords returns the words to try as the next guess, which are only the matching words when there are too many
+// pairs to score.
+func getGuessWords(matchingWords []string, allWords []string) []string {
+	if len(matchingWords)*len(allWords) > MaxScoredGuessChecks {
+		return matchingWords
+	}
+	return allWords
+}
+
+func printBestGuesses(bestGuesses []string, expectedRemaining map[string]float64) {
+	fmt.Println()
+	fmt.Println("Best guesses:")
+	fmt.Println("Guess  Expected Remaining")
+	for i, guess := range bestGuesses {
+		if i == MaxScoredGuessesToPrint {
+			break
+		}
+		fmt.Printf("%s  %.2f\n", guess, expectedRemaining[guess])
+	}
+	fmt.Println()
+	fmt.Printf("BEST GUESS: '%s'\n", bestGuesses[0])
+	fmt.Println()
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"wordtl/words"
+
+	"github.com/gookit/color"
+)
+
+var KeyboardRows = []string{"qwertyuiop", "asdfghjkl", "zxcvbnm"}
+
+// printKeyboard shows what is known about every letter, falling back to a letter and result character per key when colour is disabled.
+func printKeyboard(wordPattern string, wildcardLetters string, excludedLetters string) {
+	letterStates := words.GetLetterStates(wordPattern, wildcardLetters, excludedLetters)
+	useColor := color.Enable && color.SupportColor()
+
+	match := color.New(color.BgGreen, color.Bold)
+	almost := color.New(color.BgLightYellow, color.Bold)
+	miss := color.New(color.BgDarkGray, color.Bold)
+	untested := color.New(color.BgWhite, color.FgBlack, color.Bold)
+
+	fmt.Fprintln(UserOutput)
+	for row, keys := range KeyboardRows {
+		fmt.Fprint(UserOutput, strings.Repeat(" ", row*2))
+		for _, key := range keys {
+			letter := string(key)
+			state, tested := letterStates[letter]
+			if !useColor {
+				if !tested {
+					state = " "
+				}
+				fmt.Fprint(UserOutput, strings.ToUpper(letter)+ResultFormat.Format(state)+"  ")
+				continue
+			}
+			char := " " + strings.ToUpper(letter) + " "
+			switch state {
+			case words.MatchedChar:
+				fmt.Fprint(UserOutput, match.Sprint(char))
+			case words.WildcardChar:
+				fmt.Fprint(UserOutput, almost.Sprint(char))
+			case words.MissedChar:
+				fmt.Fprint(UserOutput, miss.Sprint(char))
+			default:
+				fmt.Fprint(UserOutput, untested.Sprint(char))
+			}
+			fmt.Fprint(UserOutput, " ")
+		}
+		fmt.Fprintln(UserOutput)
+	}
+	if !useColor {
+		fmt.Fprintln(UserOutput, "('"+ResultFormat.Matched+"' is in position, '"+ResultFormat.Wildcard+"' is out of position, '"+ResultFormat.Missed+"' is not in the word)")
+	}
+}
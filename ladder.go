@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"wordtl/words"
+)
+
+func Ladder(allWords []string) {
+	ladderFrom := strings.ToLower(strings.TrimSpace(LadderFrom))
+	ladderTo := strings.ToLower(strings.TrimSpace(LadderTo))
+	neighborsOf := strings.ToLower(strings.TrimSpace(NeighborsOf))
+	if len(neighborsOf) == 0 && (len(ladderFrom) == 0 || len(ladderTo) == 0) {
+		fmt.Printf("\nERROR: -%s and -%s, or -%s, are required, see usage with '%s %s -h'\n\n", LadderFromFlag, LadderToFlag, NeighborsFlag, os.Args[0], Mode)
+		os.Exit(1)
+	}
+	for _, word := range []string{ladderFrom, ladderTo, neighborsOf} {
+		if len(word) > 0 && len(word) != WordLength {
+			fmt.Printf("\nERROR: Ladder words must be %d letters long. '%s' is %d lettters.\n\n", WordLength, word, len(word))
+			os.Exit(1)
+		}
+	}
+
+	if len(neighborsOf) > 0 {
+		printWords(words.GetLadderNeighbors(neighborsOf, allWords), "ONE LETTER NEIGHBORS OF '"+neighborsOf+"'", "", MaxWordsToPrint)
+	}
+	if len(ladderFrom) == 0 || len(ladderTo) == 0 {
+		fmt.Println()
+		return
+	}
+
+	paths := words.GetLadderPaths(allWords, ladderFrom, ladderTo, AllLadderPaths)
+	if len(paths) == 0 {
+		fmt.Println()
+		fmt.Printf("NO LADDER from '%s' to '%s'. Please change args to get matching results.\n", ladderFrom, ladderTo)
+		fmt.Println()
+		return
+	}
+
+	fmt.Println()
+	if AllLadderPaths {
+		fmt.Printf("SHORTEST LADDERS WITH %d STEPS (%d):\n", len(paths[0])-1, len(paths))
+		if len(paths) > MaxWordsToPrint {
+			fmt.Printf("Only printing first %d\n", MaxWordsToPrint)
+			paths = paths[:MaxWordsToPrint]
+		}
+	} else {
+		fmt.Printf("SHORTEST LADDER WITH %d STEPS:\n", len(paths[0])-1)
+	}
+	for _, path := range paths {
+		fmt.Println(strings.Join(path, " -> "))
+	}
+	fmt.Println()
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"wordtl/words"
+)
+
+// Lingo solves a round of Lingo, where the first letter is revealed and every guess must begin with it.
+func Lingo(solutionWords []string, allWords []string) {
+	if WordLength < words.LingoMinWordLength || WordLength > words.LingoMaxWordLength {
+		fmt.Printf("\nERROR: Lingo words must be %d to %d letters long. Entered word length is %d.\n\n", words.LingoMinWordLength, words.LingoMaxWordLength, WordLength)
+		os.Exit(1)
+	}
+	if len(FirstLetter) > 0 {
+		var err error
+		WordPattern, err = words.RevealLetter(WordPattern, 1, strings.ToLower(FirstLetter))
+		if err != nil {
+			fmt.Println("Invalid -" + FirstLetterFlag + ": " + err.Error() + ", see usage with '" + os.Args[0] + " " + Mode + " -h'")
+			os.Exit(1)
+		}
+	}
+	firstLetter := WordPattern[0:1]
+	if firstLetter == words.WildcardChar {
+		fmt.Printf("\nERROR: The first letter must be revealed with -%s or -%s.\n\n", FirstLetterFlag, WordPatternFlag)
+		os.Exit(1)
+	}
+	fmt.Printf("Revealed pattern: '%s'\n", WordPattern)
+
+	guesses, results := getGuessesAndResults()
+	for _, guess := range guesses {
+		if !strings.HasPrefix(guess, firstLetter) {
+			fmt.Printf("\nERROR: Every guess must begin with the revealed letter '%s'. '%s' does not.\n\n", firstLetter, guess)
+			os.Exit(1)
+		}
+	}
+	WordPattern, WildcardLetters, ExcludedLetters, ExcludedByPosMap = words.TranslateAllGuessResults(guesses, results, WordPattern, ExcludedLetters, WildcardLetters, ExcludedByPosMap)
+	if len(guesses) > 0 {
+		printKeyboard(WordPattern, WildcardLetters, ExcludedLetters)
+	}
+
+	matchingWords, eliminationWords, bestEliminationWords := getWordSolutions(solutionWords, words.GetLingoGuessWords(allWords, firstLetter))
+	if guess := getBestGuess(matchingWords, eliminationWords, bestEliminationWords); len(guess) > 0 {
+		fmt.Println()
+		fmt.Printf("BEST GUESS: '%s'\n", guess)
+	}
+	fmt.Println()
+}
@@ -3,11 +3,15 @@ package main
 import (
 	"bufio"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 	"wordtl/words"
@@ -16,19 +20,46 @@ import (
 )
 
 const (
+	AllLettersFlag                = "all-letters"
+	AllPathsFlag                  = "all-paths"
+	AnswerFlag                    = "answer"
+	AnswerFileFlag                = "answer-file"
+	BullsCowsFlag                 = "bulls-cows"
+	CenterFlag                    = "center"
 	ExcludeAllFlag                = "exclude-all"
+	FirstLetterFlag               = "first-letter"
+	MaxChainWordsFlag             = "max-words"
 	ExcludeByPosFlag              = "exclude-pos"
 	DebugFlag                     = "debug"
+	LadderFromFlag                = "from"
+	LiesFlag                      = "lies"
+	LadderToFlag                  = "to"
 	WordFileFlag                  = "file"
 	GuessFlag                     = "guess"
+	GuessesFlag                   = "guesses"
 	ResultFlag                    = "guess-result"
+	ResultsFlag                   = "guess-results"
 	IgnoreWordleSolutionWordsFlag = "ignore-wordle-solution-words"
 	IgnoreWordleUsedWordsFlag     = "ignore-wordle-used-words"
 	WordLengthFlag                = "length"
+	LettersFlag                   = "letters"
 	MaxWordsToPrintFlag           = "max-print"
+	MaxMissesFlag                 = "max-misses"
+	NeighborsFlag                 = "neighbors"
 	NotInPosFlag                  = "not"
+	OperatorsFlag                 = "operators"
+	ProfileFlag                   = "profile"
+	ResultFormatFlag              = "result-format"
 	WordPatternFlag               = "pattern"
+	SidesFlag                     = "sides"
+	WordRegexFlag                 = "regex"
+	WordQueryFlag                 = "query"
 	DiagnosticsFlag               = "stats"
+	ScoresFlag                    = "scores"
+	SelfPlayFlag                  = "self-play"
+	ShareFlag                     = "share"
+	TileResultsFlag               = "tile-results"
+	TUIFlag                       = "tui"
 	UseWordleSolutionWordsFlag    = "use-wordle-solution-words"
 	UseWordleUsedWordsFlag        = "use-wordle-used-words"
 	WildcardFlag                  = "wildcards"
@@ -38,22 +69,72 @@ const (
 	ModeAutoPlay    = "auto"
 	ModeManualGuess = "manual"
 	ModeWordSearch  = "search"
+	ModeReview      = "review"
+	ModeAnagram     = "anagram"
+	ModeBee         = "bee"
+	ModeLetterBoxed = "boxed"
+	ModeWaffle      = "waffle"
+	ModeHangman     = "hangman"
+	ModeJotto       = "jotto"
+	ModeLadder      = "ladder"
+	ModeFibble      = "fibble"
+	ModeXordle      = "xordle"
+	ModeLingo       = "lingo"
+	ModeNerdle      = "nerdle"
+	ModeShell       = "shell"
 	ModeHelp        = "help"
+
+	UndoCommand = "/undo"
+	EditCommand = "/edit"
+
+	AnyWordLength   = "any"
+	NoMaxWordLength = 0
 )
 
 var (
 	WordLength       = words.WordleLength
+	MaxWordLength    = words.WordleLength // Longest word to search for, NoMaxWordLength for no limit.
 	MinWordLength    = 3
-	WordPattern      = "" // Pattern to match, length must be WordLength number of letters.
+	WordPattern      = "" // Pattern to match, length must be WordLength number of letters unless it includes '*'.
+	WordRegex        = "" // Regular expression that the whole word must match.
+	WordQuery        = "" // Boolean query that the word must match.
 	WordFile         = "" // Name/Path of text file containing 1 word per line.
 	WildcardLetters  = "" // Letters that can appear in any position where there is a wildecard placeholder.
 	ExcludedByPosStr = "" // Letters that cannot appear in the position where they are specified.
 	ExcludedByPosMap map[int]string
+	WordRegexp       *regexp.Regexp
+	PatternRegexp    *regexp.Regexp // -pattern translated for words of any length.
+	WordQueryMatcher words.Matcher
 	ExcludedLetters  = "" // Letters that cannot appear anywhere in the word.
 	PrintDiagnostics = false
 	MaxWordsToPrint  = 100
 	Guess            = ""
 	Result           = ""
+	Answer           = ""
+	AnswerFile       = "" // Name/Path of text file containing the answer for auto to play against.
+	Guesses          = "" // Comma separated guesses for a completed game.
+	Results          = "" // Comma separated results for each of the Guesses.
+	ShareText        = "" // Text from the Wordle "Share" button.
+	UseTUI           = false
+	Letters          = "" // Puzzle letters for the anagram and bee subcommands.
+	UseAllLetters    = false
+	CenterLetter     = "" // Spelling Bee letter that must be in every word.
+	LetterBoxSides   = "" // Comma separated letters on each side of a Letter Boxed puzzle.
+	MaxChainWords    = 5
+	TileResults      = "" // Result of each Waffle tile.
+	HangmanSelfPlay  = false
+	MaxMisses        = 6  // Misses that lose a game of hangman.
+	Scores           = "" // Comma separated Jotto or Bulls and Cows scores for each of the Guesses.
+	UseBullsAndCows  = false
+	LadderFrom       = ""
+	LadderTo         = ""
+	NeighborsOf      = "" // Word to list the one letter neighbors of.
+	AllLadderPaths   = false
+	Lies             = 1  // Number of tiles with the wrong colour in each Fibble result.
+	FirstLetter      = "" // Lingo letter that is revealed in the first position.
+	NerdleOperators  = words.NerdleOperators
+	ResultFormat     = words.ResultFormats[words.DefaultResultFormat] // Characters used to enter and show results.
+	Profile          = ""                                             // Name of the profile in the config file with default flag values.
 	WordleTitle      = "Wordle"
 	DoWordle         = true
 	Mode             = ModeAutoPlay
@@ -63,47 +144,176 @@ var (
 	IgnoreWordleSolutionWords = false
 	IgnoreWordleUsedWords     = false
 	Debug                     = false
+
+	UserInput            = bufio.NewReader(os.Stdin) // Answers to the prompts, shared so that answers piped in are not lost between prompts.
+	UserOutput io.Writer = os.Stdout                 // Where the prompts and everything auto plays are written.
 )
 
+// errUserExit is returned by the prompts when the user enters the exit value or there is no more input.
+var errUserExit = errors.New("user exit")
+
 func parseFlags() {
+	Profile, _ = getArgValue(os.Args[1:], ProfileFlag)
+	profile := loadProfile(Profile)
+
+	// The help strings show results in the -result-format, so it has to be known before the flags are added.
+	resultFormat, found := getArgValue(os.Args[1:], ResultFormatFlag)
+	if !found {
+		resultFormat, found = profile[ResultFormatFlag]
+	}
+	if found {
+		// An invalid value is left for the flag parsing to report.
+		resultFormatValue{}.Set(resultFormat)
+	}
+
 	wordleCmd := flag.NewFlagSet(ModeAutoPlay, flag.ExitOnError)
 	guessCmd := flag.NewFlagSet(ModeManualGuess, flag.ExitOnError)
 	searchCmd := flag.NewFlagSet(ModeWordSearch, flag.ExitOnError)
+	reviewCmd := flag.NewFlagSet(ModeReview, flag.ExitOnError)
+	anagramCmd := flag.NewFlagSet(ModeAnagram, flag.ExitOnError)
+	beeCmd := flag.NewFlagSet(ModeBee, flag.ExitOnError)
+	boxedCmd := flag.NewFlagSet(ModeLetterBoxed, flag.ExitOnError)
+	waffleCmd := flag.NewFlagSet(ModeWaffle, flag.ExitOnError)
+	hangmanCmd := flag.NewFlagSet(ModeHangman, flag.ExitOnError)
+	jottoCmd := flag.NewFlagSet(ModeJotto, flag.ExitOnError)
+	ladderCmd := flag.NewFlagSet(ModeLadder, flag.ExitOnError)
+	fibbleCmd := flag.NewFlagSet(ModeFibble, flag.ExitOnError)
+	xordleCmd := flag.NewFlagSet(ModeXordle, flag.ExitOnError)
+	lingoCmd := flag.NewFlagSet(ModeLingo, flag.ExitOnError)
+	nerdleCmd := flag.NewFlagSet(ModeNerdle, flag.ExitOnError)
+	shellCmd := flag.NewFlagSet(ModeShell, flag.ExitOnError)
 	helpCmd := flag.NewFlagSet("help", flag.ExitOnError)
 
 	subcommands := map[string]*flag.FlagSet{
-		wordleCmd.Name(): wordleCmd,
-		guessCmd.Name():  guessCmd,
-		searchCmd.Name(): searchCmd,
+		wordleCmd.Name():  wordleCmd,
+		guessCmd.Name():   guessCmd,
+		searchCmd.Name():  searchCmd,
+		reviewCmd.Name():  reviewCmd,
+		anagramCmd.Name(): anagramCmd,
+		beeCmd.Name():     beeCmd,
+		boxedCmd.Name():   boxedCmd,
+		waffleCmd.Name():  waffleCmd,
+		hangmanCmd.Name(): hangmanCmd,
+		jottoCmd.Name():   jottoCmd,
+		ladderCmd.Name():  ladderCmd,
+		fibbleCmd.Name():  fibbleCmd,
+		xordleCmd.Name():  xordleCmd,
+		lingoCmd.Name():   lingoCmd,
+		nerdleCmd.Name():  nerdleCmd,
+		shellCmd.Name():   shellCmd,
 	}
 
 	// Manual Guess Flags
 	addSearchFlags(guessCmd)
 	guessHelp := "Guess: This is your guess. Please include a Result (-" + ResultFlag + ") to filter the next guess. REQUIRED if -" + ResultFlag + " is included."
 	guessCmd.StringVar(&Guess, GuessFlag, Guess, guessHelp)
-	resultHelp := "Result: Enter the following characters for each letter in your guess - '" + words.MatchedChar + "' for matching characters, '" + words.WildcardChar + "' for matching characters that are in the wrong location, '" + words.MissedChar + "' for non-matching characters. Example value of '" + words.MissedChar + words.WildcardChar + words.MissedChar + words.MatchedChar + words.MissedChar + "' would be match for 4th character; non-match for 1st, 3rd, and 5th character; and 2nd character is in word, but not in the 2nd position."
+	resultHelp := "Result: Enter the following characters for each letter in your guess - '" + ResultFormat.Matched + "' for matching characters, '" + ResultFormat.Wildcard + "' for matching characters that are in the wrong location, '" + ResultFormat.Missed + "' for non-matching characters, '" + words.UnknownChar + "' for characters whose color is not known. See -" + ResultFormatFlag + " for other characters. Example value of '" + ResultFormat.Format("x-x=x") + "' would be match for 4th character; non-match for 1st, 3rd, and 5th character; and 2nd character is in word, but not in the 2nd position."
 	guessCmd.StringVar(&Result, ResultFlag, Result, resultHelp)
 
+	// Auto Play Flags
+	wordleCmd.BoolVar(&UseTUI, TUIFlag, UseTUI, "Play in a full-screen terminal UI with a tile grid, keyboard and live list of candidates instead of line prompts.")
+	wordleCmd.StringVar(&Answer, AnswerFlag, Answer, "OPTIONAL Answer: Play against this solution word, entering the best guess and its result for each try instead of prompting for them. Example value of 'avert'.")
+	wordleCmd.StringVar(&AnswerFile, AnswerFileFlag, AnswerFile, "OPTIONAL Answer File: Name/Path of a text file with the solution word to play against, used in place of the -"+AnswerFlag+" flag so the answer is not shown on the command line.")
+
+	// Review Flags
+	reviewCmd.StringVar(&Answer, AnswerFlag, Answer, "Answer: The solution word of the completed game. REQUIRED.")
+	reviewCmd.StringVar(&Guesses, GuessesFlag, Guesses, "Guesses: Comma separated list of your guesses in the order they were played. REQUIRED. Example value of 'roate,fleck,gived,avert'.")
+	reviewCmd.StringVar(&Results, ResultsFlag, Results, "OPTIONAL Results: Comma separated list of the Result (see -"+ResultFlag+" for the manual subcommand) for each guess. Will be calculated from the -"+AnswerFlag+" flag if neither this flag or -"+ShareFlag+" is specified. Example value of '"+ResultFormat.Format("-x---,xx=xx,xx--x,=====")+"'.")
+	reviewCmd.StringVar(&ShareText, ShareFlag, ShareText, "OPTIONAL Share Text: The text copied from the Wordle \"Share\" button, used in place of the -"+ResultsFlag+" flag.")
+
+	// Anagram Flags
+	anagramCmd.StringVar(&Letters, LettersFlag, Letters, "Letters: The letters to unscramble, each letter can be used once. Use '"+words.BlankChar+"' for a blank that can be any letter. REQUIRED. Example value of 'tnaer"+words.BlankChar+"' would lookup words made from 't', 'n', 'a', 'e', 'r' and one other letter.")
+	anagramCmd.BoolVar(&UseAllLetters, AllLettersFlag, UseAllLetters, "Only lookup words that use all of the -"+LettersFlag+".")
+
+	// Bee Flags
+	addSearchFlags(beeCmd)
+	beeCmd.StringVar(&Letters, LettersFlag, Letters, "Letters: The "+fmt.Sprintf("%d", BeeLetters)+" letters of the Spelling Bee, the -"+CenterFlag+" letter can be left out. REQUIRED. Example value of 'ptiaonc'.")
+	beeCmd.StringVar(&CenterLetter, CenterFlag, CenterLetter, "Center Letter: The letter that must be in every word. REQUIRED. Example value of 't'.")
+
+	// Letter Boxed Flags
+	boxedCmd.StringVar(&LetterBoxSides, SidesFlag, LetterBoxSides, "Sides: The letters on each side of the Letter Boxed puzzle separated by '"+words.LetterBoxSideSeparator+"'. REQUIRED. Example value of 'tal,ren,ois,cdu'.")
+	boxedCmd.IntVar(&MaxChainWords, MaxChainWordsFlag, MaxChainWords, "Max Words: The most words that a solution can use.")
+
+	// Waffle Flags
+	waffleCmd.StringVar(&Letters, LettersFlag, Letters, "Letters: The "+fmt.Sprintf("%d", words.WaffleTiles)+" letters of the Waffle grid, row by row and skipping the holes. REQUIRED. Example value of 'losolopsleaeiasossels'.")
+	waffleCmd.StringVar(&TileResults, TileResultsFlag, TileResults, "Tile Results: The color of each tile in the same order as the -"+LettersFlag+" - '"+ResultFormat.Matched+"' for green, '"+ResultFormat.Wildcard+"' for yellow, '"+ResultFormat.Missed+"' for grey. REQUIRED. Example value of '"+ResultFormat.Format("=x==-=x-==-x-x=x=x==-")+"'.")
+
+	// Hangman Flags
+	hangmanCmd.StringVar(&WordPattern, WordPatternFlag, WordPattern, "Revealed Pattern: The revealed letters in the position that they appear and '"+words.WildcardChar+"' for each letter that has not been revealed. The word length is the length of the pattern. Example value of 't"+strings.Repeat(words.WildcardChar, 2)+"t"+words.WildcardChar+"' would be a 5 letter word with a 't' in positions #1 and #4 and no other 't's.")
+	hangmanCmd.StringVar(&ExcludedLetters, ExcludeAllFlag, ExcludedLetters, "Missed Letters: Letters that were guessed and are not in the word. Example value of 'es'.")
+	hangmanCmd.BoolVar(&HangmanSelfPlay, SelfPlayFlag, HangmanSelfPlay, "Play hangman for every word and report the average number of missed letters.")
+	hangmanCmd.IntVar(&MaxMisses, MaxMissesFlag, MaxMisses, "Max Misses: The number of missed letters that loses a game when using -"+SelfPlayFlag+".")
+
+	// Jotto Flags
+	jottoCmd.StringVar(&Guesses, GuessesFlag, Guesses, "Guesses: Comma separated list of your guesses in the order they were played. Example value of 'roate,pilly'.")
+	jottoCmd.StringVar(&Scores, ScoresFlag, Scores, "Scores: Comma separated list of the score for each guess. For Jotto, the score is the number of letters shared with the word, example value of '4,0'. For Bulls and Cows, see -"+BullsCowsFlag+".")
+	jottoCmd.BoolVar(&UseBullsAndCows, BullsCowsFlag, UseBullsAndCows, "Play Bulls and Cows, where the score is the number of letters in the right position (bulls) and the number of other shared letters (cows) separated by '"+words.BullsCowsSeparator+"'. Example value of -"+ScoresFlag+" '0"+words.BullsCowsSeparator+"4,3"+words.BullsCowsSeparator+"0'.")
+
+	// Ladder Flags
+	ladderCmd.StringVar(&LadderFrom, LadderFromFlag, LadderFrom, "From: The word at the start of the ladder. Example value of 'cold'.")
+	ladderCmd.StringVar(&LadderTo, LadderToFlag, LadderTo, "To: The word at the end of the ladder, must be the same length as -"+LadderFromFlag+". Example value of 'warm'.")
+	ladderCmd.BoolVar(&AllLadderPaths, AllPathsFlag, AllLadderPaths, "List all of the shortest ladders instead of just the first one.")
+	ladderCmd.StringVar(&NeighborsOf, NeighborsFlag, NeighborsOf, "Neighbors: List the words that are one letter different from this word. Example value of 'cold'.")
+
+	// Fibble Flags
+	fibbleCmd.StringVar(&Guesses, GuessesFlag, Guesses, "Guesses: Comma separated list of your guesses in the order they were played. Example value of 'roate,fleck'.")
+	fibbleCmd.StringVar(&Results, ResultsFlag, Results, "Results: Comma separated list of the Result (see -"+ResultFlag+" for the manual subcommand) shown for each guess, including the lies. Example value of '"+ResultFormat.Format("-x=--,xx=x=")+"'.")
+	fibbleCmd.IntVar(&Lies, LiesFlag, Lies, "Lies: The number of tiles in each result that are shown with the wrong color.")
+
+	// Xordle Flags
+	xordleCmd.StringVar(&Guesses, GuessesFlag, Guesses, "Guesses: Comma separated list of your guesses in the order they were played. Example value of 'roate,fleck'.")
+	xordleCmd.StringVar(&Results, ResultsFlag, Results, "Results: Comma separated list of the Result (see -"+ResultFlag+" for the manual subcommand) shown for each guess, where each letter is colored against both answers. Example value of '"+ResultFormat.Format("xx-=-,xx=x=")+"'.")
+
+	// Lingo Flags
+	addSearchFlags(lingoCmd)
+	lingoCmd.StringVar(&FirstLetter, FirstLetterFlag, FirstLetter, "First Letter: The letter that is revealed at the start of the round. Every guess must begin with it. REQUIRED unless it is in the -"+WordPatternFlag+". Example value of 't'.")
+	lingoCmd.StringVar(&Guesses, GuessesFlag, Guesses, "Guesses: Comma separated list of your guesses in the order they were played. Example value of 'tarot,tunic'.")
+	lingoCmd.StringVar(&Results, ResultsFlag, Results, "Results: Comma separated list of the Result (see -"+ResultFlag+" for the manual subcommand) for each guess. Example value of '"+ResultFormat.Format("=xxx-,=xxxx")+"'.")
+
+	// Nerdle Flags
+	nerdleCmd.StringVar(&Guesses, GuessesFlag, Guesses, "Guesses: Comma separated list of your guesses in the order they were played. Example value of '48-32=16,9*8-7=65'.")
+	nerdleCmd.StringVar(&Results, ResultsFlag, Results, "Results: Comma separated list of the Result (see -"+ResultFlag+" for the manual subcommand) for each guess, where '"+ResultFormat.Wildcard+"' is purple. Example value of '"+ResultFormat.Format("x-xx-=x-,x=xx-=xx")+"'.")
+	nerdleCmd.StringVar(&NerdleOperators, OperatorsFlag, NerdleOperators, "Operators: The operators that can be used in the equations.")
+
+	// Shell Flags
+	addSearchFlags(shellCmd)
+
 	// Search Flags
 	addSearchFlags(searchCmd)
+	wordQueryHelp := "Query: Terms combined with '" + words.QueryAnd + "' (and), '" + words.QueryOr + "' (or), '" + words.QueryNot + "' (not) and parentheses, in addition to the other search flags. Terms are 'has:<letters>' (contains all of the letters), 'pos<n>:<letters>' (position n is any of the letters), 'count:<letter><op><number>' (op is one of = != < <= > >=), 'starts:<letters>', 'ends:<letters>', and 'contains:<letters>'. Example value of 'has:e & (has:r | has:l) & !pos3:a & count:e=2' would lookup words with two 'e's, an 'r' or an 'l', and no 'a' in position #3."
+	searchCmd.StringVar(&WordQuery, WordQueryFlag, WordQuery, wordQueryHelp)
+	searchCmd.StringVar(&WordRegex, WordRegexFlag, WordRegex, "Regular Expression: Go regular expression (https://golang.org/s/re2syntax) that the whole word must match, in addition to the other search flags. Example value of 't(ar|or)' would lookup 5 letter words that begin with 'tar' or 'tor'.")
 	useWordleSolutionWords := false
 	useWordleUsedWords := false
+	nerdleLength, nerdleMaxLength := words.NerdleLength, words.NerdleLength
 
 	// Global Flags
 	for _, fs := range subcommands {
-		fs.IntVar(&WordLength, WordLengthFlag, WordLength, "Word Length: Number of letters in each word. Wordle is 5 letters.")
-		wordFileHelp := "OPTIONAL Word File: Name/Path of ASCII text file containing one word per line. Will use the Wordle list from https://www.nytimes.com/games/wordle/index.html if this flag is not specified."
-		fs.StringVar(&WordFile, WordFileFlag, WordFile, wordFileHelp)
-		if fs == searchCmd {
-			fs.BoolVar(&useWordleSolutionWords, UseWordleSolutionWordsFlag, useWordleSolutionWords, "Consider Wordle solution words for results. Can ony be used when -"+WordFileFlag+" is NOT specified.")
-			fs.BoolVar(&useWordleUsedWords, UseWordleUsedWordsFlag, useWordleUsedWords, "Consider previously used Wordle solution words for results. Can ony be used when -"+WordFileFlag+" is NOT specified.")
+		if fs.Name() == ModeNerdle {
+			// The equations are generated rather than read from a word file, and are longer than a Wordle word.
+			nerdleLengthHelp := fmt.Sprintf("Equation Length: Number of characters in each equation, from %d to %d. Nerdle is %d characters.", words.NerdleMinLength, words.NerdleMaxLength, words.NerdleLength)
+			fs.Var(wordLengthValue{min: &nerdleLength, max: &nerdleMaxLength}, WordLengthFlag, nerdleLengthHelp)
 		} else {
-			fs.BoolVar(&IgnoreWordleSolutionWords, IgnoreWordleSolutionWordsFlag, IgnoreWordleSolutionWords, "Do not consider Wordle solution words for results. Can ony be used when -"+WordFileFlag+" is NOT specified.")
-			fs.BoolVar(&IgnoreWordleUsedWords, IgnoreWordleUsedWordsFlag, IgnoreWordleUsedWords, "Do not consider previously used Wordle solution words for results. Can ony be used when -"+WordFileFlag+" is NOT specified.")
+			wordLengthHelp := "Word Length: Number of letters in each word. Wordle is 5 letters."
+			if isDictionaryMode(fs.Name()) {
+				wordLengthHelp += " Can also be a range such as '4-7', a minimum such as '4-', or '" + AnyWordLength + "' to search words of every length."
+			}
+			fs.Var(wordLengthValue{min: &WordLength, max: &MaxWordLength}, WordLengthFlag, wordLengthHelp)
+			wordFileHelp := "OPTIONAL Word File: Name/Path of ASCII text file containing one word per line. Will use the Wordle list from https://www.nytimes.com/games/wordle/index.html if this flag is not specified."
+			fs.StringVar(&WordFile, WordFileFlag, WordFile, wordFileHelp)
+			if isDictionaryMode(fs.Name()) {
+				fs.BoolVar(&useWordleSolutionWords, UseWordleSolutionWordsFlag, useWordleSolutionWords, "Consider Wordle solution words for results. Can ony be used when -"+WordFileFlag+" is NOT specified.")
+				fs.BoolVar(&useWordleUsedWords, UseWordleUsedWordsFlag, useWordleUsedWords, "Consider previously used Wordle solution words for results. Can ony be used when -"+WordFileFlag+" is NOT specified.")
+			} else {
+				fs.BoolVar(&IgnoreWordleSolutionWords, IgnoreWordleSolutionWordsFlag, IgnoreWordleSolutionWords, "Do not consider Wordle solution words for results. Can ony be used when -"+WordFileFlag+" is NOT specified.")
+				fs.BoolVar(&IgnoreWordleUsedWords, IgnoreWordleUsedWordsFlag, IgnoreWordleUsedWords, "Do not consider previously used Wordle solution words for results. Can ony be used when -"+WordFileFlag+" is NOT specified.")
+			}
 		}
 		fs.BoolVar(&PrintDiagnostics, DiagnosticsFlag, PrintDiagnostics, "Print statistics of letter distribution for each letter position.")
 		fs.BoolVar(&Debug, DebugFlag, Debug, "Print debug information.")
 		fs.IntVar(&MaxWordsToPrint, MaxWordsToPrintFlag, MaxWordsToPrint, "Max Words to Print.")
+		fs.StringVar(&Profile, ProfileFlag, Profile, "Profile: Name of a profile in the '"+ConfigFileName+"' config file to use for the default flag values. Flags on the command line take precedence over the profile.")
+		fs.Var(resultFormatValue{}, ResultFormatFlag, "Result Format: The characters used for results, in the order matching, in the wrong location, and non-matching. One of '"+strings.Join(words.GetResultFormatNames(), "', '")+"'. Letters can be upper or lower case.")
 	}
 
 	if len(os.Args) < 2 {
@@ -123,19 +333,153 @@ func parseFlags() {
 	}
 
 	cmd.Parse(os.Args[2:])
+	applyProfile(cmd, profile, subcommands)
 	Mode = cmd.Name()
 	fmt.Println()
 	fmt.Println(getModeDescription(Mode))
 	fmt.Println()
 
-	if Mode == ModeWordSearch {
+	if isDictionaryMode(Mode) {
 		IgnoreWordleSolutionWords = !useWordleSolutionWords
 		IgnoreWordleUsedWords = !useWordleUsedWords
 	}
+
+	if WordFile != "" && !isFlagSet(cmd, WordLengthFlag) {
+		// Puzzle words can be any length, not just the length of a Wordle word.
+		switch Mode {
+		case ModeAnagram, ModeLetterBoxed:
+			WordLength, MaxWordLength = MinWordLength, NoMaxWordLength
+		case ModeBee:
+			WordLength, MaxWordLength = words.BeeMinWordLength, NoMaxWordLength
+		}
+	}
+
+	if Mode == ModeReview {
+		// The answer of a completed game has most likely been added to the used words.
+		IgnoreWordleUsedWords = true
+	}
+
+	if Mode == ModeJotto || Mode == ModeFibble || Mode == ModeXordle || Mode == ModeLingo {
+		IgnoreWordleUsedWords = true
+	}
+
+	if Mode == ModeLadder && !isFlagSet(cmd, WordLengthFlag) {
+		// The ladder words are all the same length as the start of the ladder.
+		for _, word := range []string{LadderFrom, NeighborsOf} {
+			if len(word) > 0 {
+				WordLength, MaxWordLength = len(word), len(word)
+				break
+			}
+		}
+	}
+
+	if Mode == ModeNerdle {
+		WordLength, MaxWordLength = nerdleLength, nerdleMaxLength
+	}
+
+	if Mode == ModeHangman {
+		IgnoreWordleUsedWords = true
+		if len(WordPattern) > 0 && !isFlagSet(cmd, WordLengthFlag) {
+			WordLength, MaxWordLength = len(WordPattern), len(WordPattern)
+		}
+	}
+}
+
+// isDictionaryMode reports whether the subcommand looks up words in the dictionary rather than playing Wordle, so it
+// can search a range of word lengths.
+func isDictionaryMode(mode string) bool {
+	switch mode {
+	case ModeWordSearch, ModeAnagram, ModeBee, ModeLetterBoxed:
+		return true
+	default:
+		return false
+	}
+}
+
+func isFlagSet(fs *flag.FlagSet, name string) bool {
+	isSet := false
+	fs.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			isSet = true
+		}
+	})
+	return isSet
+}
+
+// wordLengthValue parses the -length flag, which is a single length, a range such as '4-7', a minimum such as '4-', or
+// 'any'.
+type wordLengthValue struct {
+	min *int
+	max *int
+}
+
+func (v wordLengthValue) String() string {
+	if v.min == nil {
+		return ""
+	}
+	return formatWordLength(*v.min, *v.max)
+}
+
+func (v wordLengthValue) Set(value string) error {
+	if strings.ToLower(value) == AnyWordLength {
+		*v.min, *v.max = MinWordLength, NoMaxWordLength
+		return nil
+	}
+	lengths := strings.SplitN(value, "-", 2)
+	min, err := strconv.Atoi(lengths[0])
+	if err != nil {
+		return fmt.Errorf("'%s' must be a number, a range such as '4-7', or '%s'", value, AnyWordLength)
+	}
+	max := min
+	if len(lengths) == 2 {
+		max = NoMaxWordLength
+		if len(lengths[1]) > 0 {
+			if max, err = strconv.Atoi(lengths[1]); err != nil || max < min {
+				return fmt.Errorf("'%s' must end with a number greater than or equal to %d", value, min)
+			}
+		}
+	}
+	*v.min, *v.max = min, max
+	return nil
+}
+
+// resultFormatValue parses the -result-format flag into the ResultFormat.
+type resultFormatValue struct{}
+
+func (v resultFormatValue) String() string {
+	return ResultFormat.Name()
+}
+
+func (v resultFormatValue) Set(value string) error {
+	resultFormat, err := words.GetResultFormat(value)
+	if err != nil {
+		return err
+	}
+	ResultFormat = resultFormat
+	return nil
+}
+
+func formatWordLength(min int, max int) string {
+	switch max {
+	case min:
+		return fmt.Sprintf("%d", min)
+	case NoMaxWordLength:
+		return fmt.Sprintf("%d or more", min)
+	default:
+		return fmt.Sprintf("%d to %d", min, max)
+	}
+}
+
+func isWordLengthRange() bool {
+	return MaxWordLength != WordLength
+}
+
+func isWordLengthInRange(length int) bool {
+	return length >= WordLength && (MaxWordLength == NoMaxWordLength || length <= MaxWordLength)
 }
 
 func addSearchFlags(fs *flag.FlagSet) {
-	wordPatternHelp := "Pattern to Match: Known letters will be in the position that they appear. Wildecard placeholders '" + words.WildcardChar + "' 1) must include all letters specified by the -" + WildcardFlag + " flag and 2) can be any other letter that is not excluded by the -" + ExcludeAllFlag + " flag. Example value of 't" + strings.Repeat(words.WildcardChar, 4) + "' would lookup words with a 't' in the beginning of a 5 letter word."
+	wordPatternHelp := "Pattern to Match: Known letters will be in the position that they appear. Wildecard placeholders '" + words.WildcardChar + "' 1) must include all letters specified by the -" + WildcardFlag + " flag and 2) can be any other letter that is not excluded by the -" + ExcludeAllFlag + " flag. Example value of 't" + strings.Repeat(words.WildcardChar, 4) + "' would lookup words with a 't' in the beginning of a 5 letter word. A position can also be a character class of letters that can be in that position such as '[aeiou]', letters that cannot be in that position such as '[^st]', a range of letters such as '[a-f]', '" + words.VowelClass + "' for a vowel, or '" + words.ConsonantClass + "' for a consonant when they are the only capital letters. Example value of '" + words.ConsonantClass + words.VowelClass + "[^st]" + words.WildcardChar + "e' would lookup words that start with a consonant followed by a vowel, do not have an 's' or 't' in position #3 and end with 'e'. For the " + ModeWordSearch + " and " + ModeBee + " subcommands, '" + words.AnyLettersChar + "' is any number of letters so the pattern does not depend on the word length. Example value of '" + words.AnyLettersChar + "ght' would lookup words that end with 'ght' and '" + words.AnyLettersChar + "ar" + words.AnyLettersChar + "' would lookup words that contain 'ar'."
 	fs.StringVar(&WordPattern, WordPatternFlag, WordPattern, wordPatternHelp)
 	wildcardHelp := "Wildcard Letters: Letters that must appear in any position where there is a wildecard placeholder '" + words.WildcardChar + "'. Example value of 'r' means that there must be at least 1 'r' in any place where there is a '" + words.WildcardChar + "' in the -" + WordPatternFlag + " flag."
 	fs.StringVar(&WildcardLetters, WildcardFlag, WildcardLetters, wildcardHelp)
@@ -182,6 +526,32 @@ func getModeDescription(mode string) string {
 		return "Manual Guess: Get help with a single guess"
 	case ModeWordSearch:
 		return "Search All Words: dictionary lookup"
+	case ModeReview:
+		return "Review: Compare the guesses of a completed game to the solver"
+	case ModeAnagram:
+		return "Anagram: Unscramble letters into dictionary words"
+	case ModeBee:
+		return "Spelling Bee: Find the words made from the puzzle letters"
+	case ModeLetterBoxed:
+		return "Letter Boxed: Find the shortest chains of words that use every letter"
+	case ModeWaffle:
+		return "Waffle: Solve the grid with the fewest swaps"
+	case ModeHangman:
+		return "Hangman: Find the best letter to guess next"
+	case ModeJotto:
+		return "Jotto: Find the word from the number of matching letters"
+	case ModeLadder:
+		return "Word Ladder: Change one letter at a time to get from one word to another"
+	case ModeFibble:
+		return "Fibble: Solve Wordle when each result has a lie"
+	case ModeXordle:
+		return "Xordle: Find the two answers that share no letters"
+	case ModeLingo:
+		return "Lingo: Solve a round where the first letter is revealed"
+	case ModeNerdle:
+		return "Nerdle: Find the equation"
+	case ModeShell:
+		return "Shell: Enter guesses, search and switch dictionaries without restarting"
 	case ModeHelp:
 		return "Print subcommand help message"
 	default:
@@ -193,13 +563,66 @@ func initialize() ([]string, []string, map[string]bool, string, string) {
 
 	parseFlags()
 
-	fmt.Printf("Word length: %d\n", WordLength)
+	fmt.Printf("Word length: %s\n", formatWordLength(WordLength, MaxWordLength))
+	if isWordLengthRange() && !isDictionaryMode(Mode) {
+		fmt.Printf("\nERROR: A range of word lengths can't be used with the %s subcommand.\n\n", Mode)
+		os.Exit(1)
+	}
+	patternExcludedByPosMap := map[int]string{}
 	if len(WordPattern) > 0 {
-		WordPattern = strings.ToLower(WordPattern)
+		if Mode == ModeHangman || Mode == ModeLingo {
+			// The revealed letters are never the vowel and consonant shorthand.
+			WordPattern = strings.ToLower(WordPattern)
+		} else {
+			WordPattern = words.NormalizePattern(WordPattern)
+		}
 		fmt.Printf("Word pattern: '%s'\n", WordPattern)
-	} else {
+		var err error
+		if strings.Contains(WordPattern, words.AnyLettersChar) || isWordLengthRange() {
+			if Mode != ModeWordSearch && Mode != ModeBee {
+				fmt.Printf("\nERROR: '%s' can only be used in a pattern with the %s and %s subcommands.\n\n", words.AnyLettersChar, ModeWordSearch, ModeBee)
+				os.Exit(1)
+			}
+			// The pattern can't be compared position by position, so match it as a regular expression instead.
+			var patternRegex string
+			patternRegex, err = words.PatternToRegex(WordPattern)
+			if err == nil {
+				PatternRegexp, err = regexp.Compile(patternRegex)
+			}
+			WordPattern = ""
+		} else {
+			WordPattern, patternExcludedByPosMap, err = words.ParsePatternClasses(WordPattern)
+			if err == nil && Mode == ModeHangman && len(patternExcludedByPosMap) > 0 {
+				err = fmt.Errorf("character classes can't be used in a %s pattern", ModeHangman)
+			}
+		}
+		if err != nil {
+			fmt.Println("Invalid -" + WordPatternFlag + ": " + err.Error() + ", see usage with '" + os.Args[0] + " " + Mode + " -h'")
+			os.Exit(1)
+		}
+	}
+	if len(WordPattern) == 0 && !isWordLengthRange() {
 		WordPattern = strings.Repeat(words.WildcardChar, WordLength)
 	}
+	if len(WordRegex) > 0 {
+		fmt.Printf("Word regular expression: '%s'\n", WordRegex)
+		var err error
+		// The regular expression must match the whole word. Ignore case rather than lowercasing it, which changes escapes such as \W.
+		WordRegexp, err = regexp.Compile("^(?i:" + WordRegex + ")$")
+		if err != nil {
+			fmt.Println("Invalid -" + WordRegexFlag + " '" + WordRegex + "': " + err.Error())
+			os.Exit(1)
+		}
+	}
+	if len(WordQuery) > 0 {
+		fmt.Printf("Word query: '%s'\n", WordQuery)
+		var err error
+		WordQueryMatcher, err = words.ParseQuery(WordQuery)
+		if err != nil {
+			fmt.Println("Invalid -" + WordQueryFlag + " '" + WordQuery + "': " + err.Error() + ", see usage with '" + os.Args[0] + " " + Mode + " -h'")
+			os.Exit(1)
+		}
+	}
 	if len(WildcardLetters) > 0 {
 		WildcardLetters = strings.ToLower(WildcardLetters)
 		fmt.Printf("Wild Card letters: '%s'\n", WildcardLetters)
@@ -214,6 +637,15 @@ func initialize() ([]string, []string, map[string]bool, string, string) {
 			fmt.Println("Invalid JSON for -" + ExcludeByPosFlag + " '" + ExcludedByPosStr + "', see usage with '" + os.Args[0] + " " + Mode + " -h'")
 			os.Exit(1)
 		}
+	}
+	for pos, letters := range patternExcludedByPosMap {
+		for _, letter := range letters {
+			if !strings.ContainsRune(ExcludedByPosMap[pos], letter) {
+				ExcludedByPosMap[pos] += string(letter)
+			}
+		}
+	}
+	if len(ExcludedByPosMap) > 0 {
 		ints := make([]int, 0, len(ExcludedByPosMap))
 		for pos, letters := range ExcludedByPosMap {
 			letters = strings.ToLower(letters)
@@ -224,8 +656,8 @@ func initialize() ([]string, []string, map[string]bool, string, string) {
 		for _, pos := range ints {
 			if pos > 0 {
 				cantUseError := ""
-				if pos > WordLength {
-					cantUseError = " [Invalid due to word length of " + fmt.Sprintf("%d", WordLength) + "]"
+				if MaxWordLength != NoMaxWordLength && pos > MaxWordLength {
+					cantUseError = " [Invalid due to word length of " + formatWordLength(WordLength, MaxWordLength) + "]"
 				}
 				fmt.Printf("Can't use letters in postion #%d: '%s'%s\n", pos, ExcludedByPosMap[pos], cantUseError)
 			} else {
@@ -240,13 +672,16 @@ func initialize() ([]string, []string, map[string]bool, string, string) {
 		fmt.Printf("Guess:  '%s'\n", Guess)
 		fmt.Printf("Result: '%s'\n", Result)
 	}
+	Result = ResultFormat.Normalize(Result)
+	Results = ResultFormat.Normalize(Results)
+	TileResults = ResultFormat.Normalize(TileResults)
 
 	if WordLength < MinWordLength {
 		fmt.Printf("\nERROR: WordLength must be greater than %d. Entered word length is %d.\n\n", MinWordLength-1, WordLength)
 		os.Exit(1)
 	}
 
-	if len(WordPattern) != WordLength {
+	if !isWordLengthRange() && len(WordPattern) != WordLength {
 		fmt.Printf("\nERROR: WordPattern must be %d letters long. '%s' is %d lettters.\n\n", WordLength, WordPattern, len(WordPattern))
 		os.Exit(1)
 	}
@@ -266,7 +701,12 @@ func initialize() ([]string, []string, map[string]bool, string, string) {
 		os.Exit(1)
 	}
 
-	DoWordle = (WordLength == words.WordleLength) && (WordFile == "")
+	if Mode == ModeNerdle {
+		// Nerdle equations are generated instead of read from a word list.
+		return nil, nil, nil, Guess, Result
+	}
+
+	DoWordle = (WordLength == words.WordleLength) && !isWordLengthRange() && (WordFile == "")
 
 	if DoWordle {
 		fmt.Printf("Using built-in %s words.\n", WordleTitle)
@@ -317,20 +757,7 @@ func initialize() ([]string, []string, map[string]bool, string, string) {
 			}
 		}
 
-		allWords := append(words.WordleSolutionWords, words.WordleSearchWords...)
-		// Remove duplicates in all words.
-		updatedWords := []string{}
-		visited := make(map[string]bool)
-		for _, word := range allWords {
-			word = strings.ToLower(word)
-			if visited[word] {
-				continue
-			} else {
-				updatedWords = append(updatedWords, word)
-				visited[word] = true
-			}
-		}
-		allWords = updatedWords
+		allWords := getWordleAllWords()
 
 		if len(solutionWords) == 0 {
 			solutionWords = allWords
@@ -340,94 +767,134 @@ func initialize() ([]string, []string, map[string]bool, string, string) {
 
 	} else if WordFile != "" {
 		fmt.Printf("Reading Word file: %s\n", WordFile)
-		allWords := []string{}
-
-		f, err := os.Open(WordFile)
+		allWords, err := readWordFile(WordFile)
 		if err != nil {
 			log.Fatal(err)
 		}
-		defer f.Close()
-		scanner := bufio.NewScanner(f)
-		for scanner.Scan() {
-			word := strings.ToLower(scanner.Text())
-			if len(word) == WordLength {
-				allWords = append(allWords, word)
-			}
-		}
 		if len(allWords) == 0 {
-			fmt.Printf("\nERROR: '%s' does NOT include any %d letter words.\n\n", WordFile, WordLength)
+			fmt.Printf("\nERROR: '%s' does NOT include any %s letter words.\n\n", WordFile, formatWordLength(WordLength, MaxWordLength))
 			os.Exit(1)
 		}
 		return allWords, allWords, nil, Guess, Result
 	} else {
-		fmt.Printf("\nERROR: You must specify a -f <Word File> for %d letter words.\n\n", WordLength)
+		fmt.Printf("\nERROR: You must specify a -f <Word File> for %s letter words.\n\n", formatWordLength(WordLength, MaxWordLength))
 		flag.PrintDefaults()
 		os.Exit(1)
 	}
 	return nil, nil, nil, "", ""
 }
 
+// readWordFile reads the words in the word length range from a text file with one word per line.
+func readWordFile(wordFile string) ([]string, error) {
+	f, err := os.Open(wordFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	allWords := []string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		word := strings.ToLower(scanner.Text())
+		if isWordLengthInRange(len(word)) {
+			allWords = append(allWords, word)
+		}
+	}
+	return allWords, scanner.Err()
+}
+
+// getWordleAllWords returns the built-in Wordle solution and search words without any duplicates.
+func getWordleAllWords() []string {
+	allWords := append(words.WordleSolutionWords, words.WordleSearchWords...)
+	// Remove duplicates in all words.
+	updatedWords := []string{}
+	visited := make(map[string]bool)
+	for _, word := range allWords {
+		word = strings.ToLower(word)
+		if visited[word] {
+			continue
+		} else {
+			updatedWords = append(updatedWords, word)
+			visited[word] = true
+		}
+	}
+	return updatedWords
+}
+
 func printWords(words []string, description string, exclamation string, maxToPrint int) {
+	printHighlightedWords(words, description, exclamation, maxToPrint, nil)
+}
+
+// printHighlightedWords prints the words like printWords, printing each word with highlight when it is not nil.
+func printHighlightedWords(words []string, description string, exclamation string, maxToPrint int, highlight func(word string) string) {
 	if len(words) == 0 {
-		fmt.Printf("\nNo %s!\n", description)
+		fmt.Fprintf(UserOutput, "\nNo %s!\n", description)
 		return
 	}
 
 	if len(words) == 1 && len(exclamation) > 0 {
-		fmt.Printf("\n%s - %s! - '%s'\n", description, exclamation, words[0])
+		fmt.Fprintf(UserOutput, "\n%s - %s! - '%s'\n", description, exclamation, words[0])
 		return
 	}
 
-	fmt.Printf("\n%s (%d):\n", description, len(words))
+	fmt.Fprintf(UserOutput, "\n%s (%d):\n", description, len(words))
 	if len(words) > maxToPrint {
-		fmt.Printf("Only printing first %d\n", maxToPrint)
+		fmt.Fprintf(UserOutput, "Only printing first %d\n", maxToPrint)
 	}
 	lineLength := 0
 	sortedWords := []string{}
 	sortedWords = append(sortedWords, words...) // Create a copy so sort does not disturb the original array.
 	sort.Strings(sortedWords)
 	for i, word := range sortedWords {
-		fmt.Print(word)
+		if highlight != nil {
+			fmt.Fprint(UserOutput, highlight(word))
+		} else {
+			fmt.Fprint(UserOutput, word)
+		}
 		lineLength += len(word) + 1
 		if lineLength+len(word) > 80 {
-			fmt.Println()
+			fmt.Fprintln(UserOutput)
 			lineLength = 0
 		} else {
-			fmt.Print(" ")
+			fmt.Fprint(UserOutput, " ")
 		}
 		if i == maxToPrint {
 			break
 		}
 	}
-	fmt.Println()
+	fmt.Fprintln(UserOutput)
 }
 
 func printLettersToTry(letters map[string]int) {
 	if len(letters) == 0 {
-		fmt.Println("\nNo additional letters to try!")
+		fmt.Fprintln(UserOutput, "\nNo additional letters to try!")
 		return
 	}
 
-	fmt.Printf("\nTry these letters (%d):\n", len(letters))
+	fmt.Fprintf(UserOutput, "\nTry these letters (%d):\n", len(letters))
 	// Sort letters in order of most occurances first.
 	keys := make([]string, 0, len(letters))
 	for k := range letters {
 		keys = append(keys, k)
 	}
 	sort.Slice(keys, func(i, j int) bool {
+		if letters[keys[i]] == letters[keys[j]] {
+			// Break ties alphabetically so the output is the same on every run.
+			return keys[i] < keys[j]
+		}
 		return letters[keys[i]] > letters[keys[j]]
 	})
 
 	for _, k := range keys {
-		fmt.Printf("%s=%d ", k, letters[k])
+		fmt.Fprintf(UserOutput, "%s=%d ", k, letters[k])
 	}
-	fmt.Println()
+	fmt.Fprintln(UserOutput)
 }
 
 func printWordDiagnostics(letterDistribution []map[string]int, wordLength int) {
-	fmt.Println()
+	fmt.Fprintln(UserOutput)
 	if len(letterDistribution) == 0 {
-		fmt.Println("\nNo statistics to print!")
+		fmt.Fprintln(UserOutput, "\nNo statistics to print!")
 		return
 	}
 
@@ -437,40 +904,56 @@ func printWordDiagnostics(letterDistribution []map[string]int, wordLength int) {
 			keys = append(keys, k)
 		}
 		sort.Slice(keys, func(i, j int) bool {
+			if letterDistribution[position][keys[i]] == letterDistribution[position][keys[j]] {
+				return keys[i] < keys[j]
+			}
 			return letterDistribution[position][keys[i]] > letterDistribution[position][keys[j]]
 		})
 
-		fmt.Printf("Letter distribution for position #%d:\n", position+1)
+		fmt.Fprintf(UserOutput, "Letter distribution for position #%d:\n", position+1)
 		for _, k := range keys {
 			if letterDistribution[position][k] > 0 {
-				fmt.Printf("%s=%d ", k, letterDistribution[position][k])
+				fmt.Fprintf(UserOutput, "%s=%d ", k, letterDistribution[position][k])
 			}
 		}
-		fmt.Println()
+		fmt.Fprintln(UserOutput)
 	}
 
 }
 
-func getWordSolutions(solutionWords []string, allWords []string) ([]string, []string, []string) {
+func findWordSolutions(solutionWords []string, allWords []string) ([]string, []string, []string) {
 	eliminationWords := []string{}
 	bestEliminationWords := []string{}
 
 	matchingWords := words.GetMatchingWords(solutionWords, WordPattern, ExcludedLetters, WildcardLetters, true, ExcludedByPosMap)
-	printWords(matchingWords, "MATCHING WORDS", "EXACT MATCH", MaxWordsToPrint)
 	if len(matchingWords) > 1 {
 		remainingLetterCount, remainingLetterOrder := words.GetLetterCount(matchingWords, WordPattern, WildcardLetters)
 		remainingLetterDistribution := words.GetLetterDistribution(matchingWords, WordLength)
+		if len(remainingLetterOrder) > 0 {
+			eliminationWords = words.GetEliminationWords(remainingLetterOrder, allWords, WordLength, ExcludedLetters, WildcardLetters, ExcludedByPosMap)
+			if len(eliminationWords) > 1 {
+				bestEliminationWords = words.GetBestEliminationWords(matchingWords, eliminationWords, WordLength, remainingLetterOrder, remainingLetterCount, remainingLetterDistribution, Debug)
+			}
+		}
+	}
+	return matchingWords, eliminationWords, bestEliminationWords
+}
+
+func getWordSolutions(solutionWords []string, allWords []string) ([]string, []string, []string) {
+	matchingWords, eliminationWords, bestEliminationWords := findWordSolutions(solutionWords, allWords)
+	printWords(matchingWords, "MATCHING WORDS", "EXACT MATCH", MaxWordsToPrint)
+	if len(matchingWords) > 1 {
+		remainingLetterCount, remainingLetterOrder := words.GetLetterCount(matchingWords, WordPattern, WildcardLetters)
 		printLettersToTry(remainingLetterCount)
 		if PrintDiagnostics {
-			printWordDiagnostics(remainingLetterDistribution, WordLength)
+			printWordDiagnostics(words.GetLetterDistribution(matchingWords, WordLength), WordLength)
 		}
 		if len(remainingLetterOrder) > 0 {
-			eliminationWords = words.GetEliminationWords(remainingLetterOrder, allWords, WordLength, ExcludedLetters, WildcardLetters, ExcludedByPosMap)
+			fmt.Fprintf(UserOutput, "\nTrying elimination letters: '%s'\n", remainingLetterOrder)
 			if len(eliminationWords) < 2*MaxWordsToPrint {
 				printWords(eliminationWords, "ELIMINATION WORDS", "BEST CHOICE", MaxWordsToPrint)
 			}
 			if len(eliminationWords) > 1 {
-				bestEliminationWords = words.GetBestEliminationWords(matchingWords, eliminationWords, WordLength, remainingLetterOrder, remainingLetterCount, remainingLetterDistribution, Debug)
 				printWords(bestEliminationWords, "BEST ELIMINATION WORDS", "BEST CHOICE", MaxWordsToPrint)
 				if len(bestEliminationWords) > 1 {
 					bestEliminationWord := []string{}
@@ -483,10 +966,8 @@ func getWordSolutions(solutionWords []string, allWords []string) ([]string, []st
 	return matchingWords, eliminationWords, bestEliminationWords
 }
 
-func getBestGuess(matchingWords []string, eliminationWords []string, bestEliminationWords []string) string {
+func pickBestGuess(matchingWords []string, eliminationWords []string, bestEliminationWords []string) string {
 	if len(matchingWords) == 1 || len(matchingWords) == 2 {
-		fmt.Println()
-		fmt.Println("Using MATCHING WORD - '" + matchingWords[0] + "'")
 		return matchingWords[0]
 	} else if len(bestEliminationWords) > 0 {
 		return bestEliminationWords[0]
@@ -498,6 +979,14 @@ func getBestGuess(matchingWords []string, eliminationWords []string, bestElimina
 	return ""
 }
 
+func getBestGuess(matchingWords []string, eliminationWords []string, bestEliminationWords []string) string {
+	if len(matchingWords) == 1 || len(matchingWords) == 2 {
+		fmt.Fprintln(UserOutput)
+		fmt.Fprintln(UserOutput, "Using MATCHING WORD - '"+matchingWords[0]+"'")
+	}
+	return pickBestGuess(matchingWords, eliminationWords, bestEliminationWords)
+}
+
 func printNextGuess(
 	guess string,
 	wordPattern string,
@@ -561,10 +1050,20 @@ func printNextGuess(
 		ignoreWordleUsedWordsFlag = "-" + IgnoreWordleUsedWordsFlag + " "
 	}
 
-	fmt.Printf("\nTry:\n%s %s %s%s%s%s%s%s%s%s%s\n", os.Args[0], Mode, wordFileArg, wordLengthArg, ignoreWordleSolutionWordsFlag, ignoreWordleUsedWordsFlag, wordPatternArgs, wildcardLettersArgs, excludedByPosStr, excludedLettersArgs, guessArgs)
+	profileArg := ""
+	if Profile != "" {
+		profileArg = "-" + ProfileFlag + " " + Profile + " "
+	}
+
+	resultFormatArg := ""
+	if ResultFormat.Name() != words.DefaultResultFormat {
+		resultFormatArg = "-" + ResultFormatFlag + " '" + ResultFormat.Name() + "' "
+	}
+
+	fmt.Printf("\nTry:\n%s %s %s%s%s%s%s%s%s%s%s%s%s\n", os.Args[0], Mode, profileArg, wordFileArg, wordLengthArg, ignoreWordleSolutionWordsFlag, ignoreWordleUsedWordsFlag, resultFormatArg, wordPatternArgs, wildcardLettersArgs, excludedByPosStr, excludedLettersArgs, guessArgs)
 }
 
-func getUserInputRange(defaultVal string, valName string, startChar string, endChar string, validCharsMsg string, validCharsHelp string, validLength int) string {
+func getUserInputRange(defaultVal string, valName string, startChar string, endChar string, validCharsMsg string, validCharsHelp string, validLength int) (string, error) {
 	validChars := ""
 	if startChar[0] < endChar[0] && len(startChar) == 1 && len(endChar) == 1 {
 		for char := startChar[0]; char <= endChar[0]; char++ {
@@ -572,63 +1071,79 @@ func getUserInputRange(defaultVal string, valName string, startChar string, endC
 		}
 		return getUserInput(defaultVal, valName, validChars, validCharsMsg, validCharsHelp, validLength)
 	}
-	fmt.Println()
-	fmt.Println("Invalid range starting with '" + startChar + "' and ending with '" + endChar + "'.")
-	fmt.Println()
-	return ""
+	fmt.Fprintln(UserOutput)
+	fmt.Fprintln(UserOutput, "Invalid range starting with '"+startChar+"' and ending with '"+endChar+"'.")
+	fmt.Fprintln(UserOutput)
+	return "", nil
 }
 
-func getUserInput(defaultVal string, valName string, validChars string, validCharsMsg string, validCharsHelp string, validLength int) string {
-	// TODO: Add unit test
+func readUserInput(defaultVal string, valName string) (string, error) {
 	exitStr := "0"
 	defaultStr := ""
-	userInput := ""
 	if len(defaultVal) > 0 {
 		defaultStr = "default = '" + defaultVal + "', "
 	}
-	for {
-		fmt.Print(valName, " (", defaultStr, "exit = '"+exitStr+"'): ")
-		reader := bufio.NewReader(os.Stdin)
-		userInput, _ = reader.ReadString('\n')
-		userInput = strings.TrimSuffix(userInput, "\n")
-		userInput = strings.ToLower(userInput)
-		if userInput == "" {
-			userInput = defaultVal
-		}
-		if len(userInput) == len(exitStr) && userInput == exitStr {
-			os.Exit(0)
-		}
-		if len(userInput) == validLength {
-			validInput := true
-			invalidChars := ""
-			for _, thisChar := range userInput {
-				letter := string(thisChar)
-				if !strings.Contains(strings.ToLower(validChars), letter) {
-					validInput = false
-					invalidChars += letter
-				}
-			}
-			if validInput {
-				break
-			} else {
-				fmt.Println()
-				fmt.Println("Value must contain only the following characters: '" + validCharsMsg + "'" + validCharsHelp + ". Your input: '" + userInput + "' includes the following invalid characters: '" + invalidChars + "'.")
-				fmt.Println()
-			}
-		} else {
-			fmt.Println()
-			fmt.Println("Value must be " + fmt.Sprintf("%d", validLength) + " characters, '" + userInput + "' is " + fmt.Sprintf("%d", len(userInput)) + " characters.")
-			fmt.Println()
+	fmt.Fprint(UserOutput, valName, " (", defaultStr, "exit = '"+exitStr+"'): ")
+	userInput, err := UserInput.ReadString('\n')
+	if err != nil && (err != io.EOF || userInput == "") {
+		// The end of a piped script exits rather than taking the default forever.
+		fmt.Fprintln(UserOutput)
+		return "", errUserExit
+	}
+	userInput = strings.TrimSuffix(userInput, "\n")
+	userInput = strings.TrimSuffix(userInput, "\r")
+	userInput = strings.ToLower(userInput)
+	if userInput == "" {
+		userInput = defaultVal
+	}
+	if userInput == exitStr {
+		return "", errUserExit
+	}
+	return userInput, nil
+}
+
+func isValidUserInput(userInput string, validChars string, validCharsMsg string, validCharsHelp string, validLength int) bool {
+	if len(userInput) != validLength {
+		fmt.Fprintln(UserOutput)
+		fmt.Fprintln(UserOutput, "Value must be "+fmt.Sprintf("%d", validLength)+" characters, '"+userInput+"' is "+fmt.Sprintf("%d", len(userInput))+" characters.")
+		fmt.Fprintln(UserOutput)
+		return false
+	}
+
+	validInput := true
+	invalidChars := ""
+	for _, thisChar := range userInput {
+		letter := string(thisChar)
+		if !strings.Contains(strings.ToLower(validChars), letter) {
+			validInput = false
+			invalidChars += letter
 		}
 	}
+	if !validInput {
+		fmt.Fprintln(UserOutput)
+		fmt.Fprintln(UserOutput, "Value must contain only the following characters: '"+validCharsMsg+"'"+validCharsHelp+". Your input: '"+userInput+"' includes the following invalid characters: '"+invalidChars+"'.")
+		fmt.Fprintln(UserOutput)
+	}
+	return validInput
+}
 
-	return userInput
+func getUserInput(defaultVal string, valName string, validChars string, validCharsMsg string, validCharsHelp string, validLength int) (string, error) {
+	for {
+		userInput, err := readUserInput(defaultVal, valName)
+		if err != nil {
+			return "", err
+		}
+		if isValidUserInput(userInput, validChars, validCharsMsg, validCharsHelp, validLength) {
+			return userInput, nil
+		}
+	}
 }
 
 func printWordleResult(guess string, result string) bool {
 	match := color.New(color.BgGreen, color.Bold)
 	almost := color.New(color.BgLightYellow, color.Bold)
 	miss := color.New(color.BgDarkGray, color.Bold)
+	unknown := color.New(color.BgWhite, color.FgBlack, color.Bold)
 	incorrect := color.New(color.BgHiRed, color.Bold)
 	correctForm := true
 
@@ -637,48 +1152,50 @@ func printWordleResult(guess string, result string) bool {
 			char := strings.ToUpper(string(letter))
 			switch string(result[ndx]) {
 			case words.MatchedChar:
-				match.Print(" " + string(char) + " ")
+				fmt.Fprint(UserOutput, match.Sprint(" "+string(char)+" "))
 			case words.MissedChar:
-				miss.Print(" " + string(char) + " ")
+				fmt.Fprint(UserOutput, miss.Sprint(" "+string(char)+" "))
 			case words.WildcardChar:
-				almost.Print(" " + string(char) + " ")
+				fmt.Fprint(UserOutput, almost.Sprint(" "+string(char)+" "))
+			case words.UnknownChar:
+				fmt.Fprint(UserOutput, unknown.Sprint(" "+string(char)+" "))
 			default:
-				incorrect.Print(" " + string(char) + " ")
+				fmt.Fprint(UserOutput, incorrect.Sprint(" "+string(char)+" "))
 				correctForm = false
 			}
 			if ndx < len(guess)-1 {
-				fmt.Print(" ")
+				fmt.Fprint(UserOutput, " ")
 			}
 		}
-		fmt.Println()
+		fmt.Fprintln(UserOutput)
 
 		if !correctForm {
-			fmt.Println("Result: '" + result + "' must be in the proper form.")
+			fmt.Fprintln(UserOutput, "Result: '"+ResultFormat.Format(result)+"' must be in the proper form.")
 		}
 	} else {
-		fmt.Println("Guess: '" + guess + "' and Result: '" + result + "' must be the same length.")
+		fmt.Fprintln(UserOutput, "Guess: '"+guess+"' and Result: '"+ResultFormat.Format(result)+"' must be the same length.")
 		correctForm = false
 	}
 
 	return correctForm
 }
 
-func printWordleSolution(guesses [MaxTries]string, results [MaxTries]string, numTries int, foundSolution bool) {
+func printWordleSolution(guesses []string, results []string, foundSolution bool) {
 	if foundSolution {
-		fmt.Println()
-		fmt.Println("Congratulations, you have found the solution word in " + fmt.Sprintf("%d", numTries+1) + " turns!")
-		fmt.Println()
+		fmt.Fprintln(UserOutput)
+		fmt.Fprintln(UserOutput, "Congratulations, you have found the solution word in "+fmt.Sprintf("%d", len(guesses))+" turns!")
+		fmt.Fprintln(UserOutput)
 	} else {
-		if numTries+1 > 1 {
-			fmt.Println()
-			fmt.Println("Result after " + fmt.Sprintf("%d", numTries+1) + " guesses:")
+		if len(guesses) > 1 {
+			fmt.Fprintln(UserOutput)
+			fmt.Fprintln(UserOutput, "Result after "+fmt.Sprintf("%d", len(guesses))+" guesses:")
 		} else {
 			return
 		}
 	}
-	for i := 0; i <= numTries; i++ {
+	for i := range guesses {
 		printWordleResult(guesses[i], results[i])
-		fmt.Println()
+		fmt.Fprintln(UserOutput)
 	}
 }
 
@@ -693,48 +1210,97 @@ func isResultCorrect(result string, validlength int) bool {
 	return correct
 }
 
-func WordSearch(solutionWords []string) {
-	if len(WildcardLetters) > 0 {
-		letterCount := map[string]int{}
-		for _, letter := range WildcardLetters {
-			letterCount[string(letter)]++
-		}
-		letterDistribution := []map[string]int{}
-		for position := 0; position < WordLength; position++ {
-			letterDistribution = append(letterDistribution, map[string]int{})
-			for _, letter := range WildcardLetters {
-				letterDistribution[position][string(letter)]++
+func getWordSearchTitle() string {
+	wordSearchTitle := "ALL"
+	if DoWordle {
+		wordSearchTitle += " " + strings.ToUpper(WordleTitle)
+		if !IgnoreWordleSolutionWords {
+			wordSearchTitle += " SOLUTION"
+			if !IgnoreWordleUsedWords {
+				wordSearchTitle += " (minus USED)"
 			}
 		}
+	}
+	return wordSearchTitle
+}
 
-		matchingWords := words.GetMatchingWords(solutionWords, WordPattern, ExcludedLetters, "", false, ExcludedByPosMap)
-		if len(matchingWords) > 1 {
-			matchingWords = words.GetBestEliminationWords([]string{}, matchingWords, WordLength, WildcardLetters, letterCount, letterDistribution, Debug)
+func WordSearch(solutionWords []string) {
+	if WordRegexp != nil {
+		solutionWords = words.GetRegexMatchingWords(solutionWords, WordRegexp)
+	}
+	if WordQueryMatcher != nil {
+		solutionWords = words.GetQueryMatchingWords(solutionWords, WordQueryMatcher)
+	}
+	if PatternRegexp != nil {
+		solutionWords = words.GetRegexMatchingWords(solutionWords, PatternRegexp)
+	}
+
+	if len(WildcardLetters) == 0 && len(strings.Trim(WordPattern, words.WildcardChar)) == 0 && len(ExcludedLetters) == 0 && len(ExcludedByPosMap) == 0 && WordRegexp == nil && WordQueryMatcher == nil && PatternRegexp == nil {
+		wordSearchHelp := "Nothing to SEARCH! Please use the -" + WildcardFlag + ", -" + WordPatternFlag + ", -" + WordQueryFlag + ", -" + WordRegexFlag + ", -" + ExcludeAllFlag + ", or -" + ExcludeByPosFlag + " flags to specify what to search for."
+		fmt.Println()
+		fmt.Println(wordSearchHelp)
+		fmt.Println()
+		return
+	}
+
+	// Search each word length separately, shortest words first.
+	wordsByLength := map[int][]string{}
+	for _, word := range solutionWords {
+		wordsByLength[len(word)] = append(wordsByLength[len(word)], word)
+	}
+	lengths := make([]int, 0, len(wordsByLength))
+	for length := range wordsByLength {
+		lengths = append(lengths, length)
+	}
+	sort.Ints(lengths)
+
+	foundWords := false
+	for _, length := range lengths {
+		wordPattern := WordPattern
+		if len(wordPattern) != length {
+			wordPattern = strings.Repeat(words.WildcardChar, length)
 		}
-		if len(matchingWords) > 0 {
-			wordSearchTitle := "ALL"
-			if DoWordle {
-				wordSearchTitle += " " + strings.ToUpper(WordleTitle)
-				if !IgnoreWordleSolutionWords {
-					wordSearchTitle += " SOLUTION"
-					if !IgnoreWordleUsedWords {
-						wordSearchTitle += " (minus USED)"
-					}
-				}
-			}
-			printWords(matchingWords, "SEARCH "+wordSearchTitle+" WORDS", "EXACT MATCH", MaxWordsToPrint)
-		} else {
-			fmt.Println()
-			fmt.Println("NO MATCHING WORDS. Please change args to get matching results.")
-			fmt.Println()
+		matchingWords := searchWords(wordsByLength[length], wordPattern)
+		if len(matchingWords) == 0 {
+			continue
 		}
+		foundWords = true
+		description := "SEARCH " + getWordSearchTitle() + " WORDS"
+		if isWordLengthRange() {
+			description += fmt.Sprintf(" WITH %d LETTERS", length)
+		}
+		printWords(matchingWords, description, "EXACT MATCH", MaxWordsToPrint)
+	}
+	if !foundWords {
 		fmt.Println()
-	} else {
-		wordSearchHelp := "Nothing to SEARCH! Please use the -" + WildcardFlag + " flag to specify letters to search for."
-		fmt.Println()
-		fmt.Println(wordSearchHelp)
+		fmt.Println("NO MATCHING WORDS. Please change args to get matching results.")
 		fmt.Println()
 	}
+	fmt.Println()
+}
+
+// searchWords finds the words of a single length that match the search flags, ranked by the wildcard letters when
+// there are any.
+func searchWords(solutionWords []string, wordPattern string) []string {
+	matchingWords := words.GetMatchingWords(solutionWords, wordPattern, ExcludedLetters, "", false, ExcludedByPosMap)
+	if len(WildcardLetters) == 0 || len(matchingWords) <= 1 {
+		return matchingWords
+	}
+
+	wordLength := len(wordPattern)
+	letterCount := map[string]int{}
+	for _, letter := range WildcardLetters {
+		letterCount[string(letter)]++
+	}
+	letterDistribution := []map[string]int{}
+	for position := 0; position < wordLength; position++ {
+		letterDistribution = append(letterDistribution, map[string]int{})
+		for _, letter := range WildcardLetters {
+			letterDistribution[position][string(letter)]++
+		}
+	}
+
+	return words.GetBestEliminationWords([]string{}, matchingWords, wordLength, WildcardLetters, letterCount, letterDistribution, Debug)
 }
 
 func ManualGuess(guess string, result string, solutionWords []string, allWords []string) {
@@ -743,7 +1309,18 @@ func ManualGuess(guess string, result string, solutionWords []string, allWords [
 		fmt.Println("Congratulations, '" + guess + "' is the solution word!")
 		fmt.Println()
 	} else {
+		conflicts := words.GetConflicts(guess, result, WordPattern, ExcludedLetters, WildcardLetters, ExcludedByPosMap)
+		if len(conflicts) > 0 {
+			fmt.Println()
+			fmt.Println("ERROR: Result '" + ResultFormat.Format(result) + "' for '" + guess + "' conflicts with the -" + WordPatternFlag + ", -" + WildcardFlag + ", -" + ExcludeAllFlag + ", or -" + ExcludeByPosFlag + " flags:")
+			for _, conflict := range conflicts {
+				fmt.Println("   " + conflict)
+			}
+			fmt.Println()
+			os.Exit(1)
+		}
 		WordPattern, WildcardLetters, ExcludedLetters, ExcludedByPosMap = words.TranslateGuessResults(guess, result, WordPattern, ExcludedLetters, WildcardLetters, ExcludedByPosMap)
+		printKeyboard(WordPattern, WildcardLetters, ExcludedLetters)
 		matchingWords, eliminationWords, bestEliminationWords := getWordSolutions(solutionWords, allWords)
 		guess = getBestGuess(matchingWords, eliminationWords, bestEliminationWords)
 		printNextGuess(guess, WordPattern, WildcardLetters, ExcludedLetters, ExcludedByPosMap)
@@ -751,20 +1328,109 @@ func ManualGuess(guess string, result string, solutionWords []string, allWords [
 	}
 }
 
+func getGuessOrCommand(defaultGuess string) (string, error) {
+	for {
+		userInput, err := readUserInput(defaultGuess, "Enter your Guess")
+		if err != nil {
+			return "", err
+		}
+		if userInput == UndoCommand || strings.HasPrefix(userInput, EditCommand) {
+			return userInput, nil
+		}
+		if isValidUserInput(userInput, "abcdefghijklmnopqrstuvwxyz", "a-z", "", WordLength) {
+			return userInput, nil
+		}
+	}
+}
+
+// getTryConflicts checks a guess and result against each of the other tries on its own, so the conflicting try can be reported.
+func getTryConflicts(guess string, result string, guesses []string, results []string, skipTry int) []string {
+	conflicts := []string{}
+	for i := range guesses {
+		if i+1 == skipTry {
+			continue
+		}
+		wordPattern, wildcardLetters, excludedLetters, excludedByPosMap := words.TranslateGuessResults(guesses[i], results[i], strings.Repeat(words.WildcardChar, WordLength), "", "", map[int]string{})
+		for _, conflict := range words.GetConflicts(guess, result, wordPattern, excludedLetters, wildcardLetters, excludedByPosMap) {
+			conflicts = append(conflicts, "TRY #"+fmt.Sprintf("%d", i+1)+" '"+guesses[i]+"': "+conflict)
+		}
+	}
+	return conflicts
+}
+
+func getConfirmedResult(guess string, defaultResult string, guesses []string, results []string, skipTry int) (string, bool, error) {
+	const (
+		yes = "y"
+		no  = "n"
+	)
+
+	result, err := getUserInput(ResultFormat.Format(defaultResult), "Enter your Result", ResultFormat.Name()+words.DefaultResultFormat+words.UnknownChar, ResultFormat.Name()+words.UnknownChar, " (where '"+ResultFormat.Matched+"' is a matching character in position, '"+ResultFormat.Wildcard+"' is a matching character out of position, '"+ResultFormat.Missed+"' is a non-matching character, and '"+words.UnknownChar+"' is a character whose color is not known)", WordLength)
+	if err != nil {
+		return "", false, err
+	}
+	result = ResultFormat.Normalize(result)
+	fmt.Fprintln(UserOutput)
+	correctForm := printWordleResult(guess, result)
+	fmt.Fprintln(UserOutput)
+
+	if correctForm {
+		defaultCorrect := yes
+		conflicts := getTryConflicts(guess, result, guesses, results, skipTry)
+		if len(conflicts) > 0 {
+			fmt.Fprintln(UserOutput, "WARNING: This result conflicts with other tries:")
+			for _, conflict := range conflicts {
+				fmt.Fprintln(UserOutput, "   "+conflict)
+			}
+			fmt.Fprintln(UserOutput)
+			defaultCorrect = no
+		}
+		correct, err := getUserInput(defaultCorrect, "Is this correct?", yes+no, yes+" or "+no, "", 1)
+		return result, correct == yes, err
+	}
+	return result, false, nil
+}
+
+// editTry returns the number of the try to edit from an edit command, defaulting to the previous try.
+func editTry(command string, numTries int) int {
+	args := strings.Fields(strings.TrimPrefix(command, EditCommand))
+	if len(args) == 0 {
+		return numTries
+	}
+	try, err := strconv.Atoi(args[0])
+	if err != nil || len(args) > 1 || try < 1 || try > numTries {
+		return 0
+	}
+	return try
+}
+
 func AutoPlay(guess string, result string, solutionWords []string, allWords []string, usedWords map[string]bool) {
 	const (
 		yes = "y"
 		no  = "n"
 	)
 
-	var guesses [MaxTries]string
-	var results [MaxTries]string
-	for try := 0; try < MaxTries; try++ {
-		WordPattern, WildcardLetters, ExcludedLetters, ExcludedByPosMap = words.TranslateGuessResults(guess, result, WordPattern, ExcludedLetters, WildcardLetters, ExcludedByPosMap)
+	// Keep every try so that the constraints can be rebuilt from scratch when a try is undone or edited.
+	WordPattern, WildcardLetters, ExcludedLetters, ExcludedByPosMap = words.TranslateGuessResults(guess, result, WordPattern, ExcludedLetters, WildcardLetters, ExcludedByPosMap)
+	startWordPattern, startWildcardLetters, startExcludedLetters, startExcludedByPosMap := WordPattern, WildcardLetters, ExcludedLetters, ExcludedByPosMap
+	guesses := []string{}
+	results := []string{}
+
+	for len(guesses) < MaxTries {
+		WordPattern, WildcardLetters, ExcludedLetters, ExcludedByPosMap = words.TranslateAllGuessResults(guesses, results, startWordPattern, startExcludedLetters, startWildcardLetters, startExcludedByPosMap)
+		if len(guesses) > 0 {
+			printKeyboard(WordPattern, WildcardLetters, ExcludedLetters)
+		}
 		matchingWords, eliminationWords, bestEliminationWords := getWordSolutions(solutionWords, allWords)
 		if (len(matchingWords) == 0) && (len(solutionWords) != len(allWords)) {
-			fmt.Println()
-			useAllWords := getUserInput(yes, "No matching words found in Solution Words.\n\nDo you want to search All Words?", yes+no, yes+" or "+no, "", 1)
+			fmt.Fprintln(UserOutput)
+			useAllWords := yes
+			if len(Answer) == 0 {
+				var err error
+				useAllWords, err = getUserInput(yes, "No matching words found in Solution Words.\n\nDo you want to search All Words?", yes+no, yes+" or "+no, "", 1)
+				if err != nil {
+					return
+				}
+			}
 			if useAllWords == yes {
 				IgnoreWordleSolutionWords = true
 				solutionWords = allWords
@@ -782,58 +1448,195 @@ func AutoPlay(guess string, result string, solutionWords []string, allWords []st
 		}
 		guess = getBestGuess(matchingWords, eliminationWords, bestEliminationWords)
 
-		fmt.Println()
-		fmt.Println("TRY #" + fmt.Sprintf("%d", try+1))
-		fmt.Println("------")
-		fmt.Println()
-		userGuess := guess
-		userResult := result
-		for {
-			userGuess = getUserInputRange(userGuess, "Enter your Guess", "a", "z", "a-z", "", WordLength)
-			userResult = getUserInput("", "Enter your Result", words.MatchedChar+words.WildcardChar+words.MissedChar, words.MatchedChar+words.WildcardChar+words.MissedChar, " (where '"+words.MatchedChar+"' is a matching character in position, '"+words.WildcardChar+"' is a matching character out of position, and '"+words.MissedChar+"' is a non-matching character)", WordLength)
-			fmt.Println()
-			correctForm := printWordleResult(userGuess, userResult)
-			fmt.Println()
-
-			if correctForm {
-				correct := getUserInput(yes, "Is this correct?", yes+no, yes+" or "+no, "", 1)
-				if correct == yes {
+		fmt.Fprintln(UserOutput)
+		fmt.Fprintln(UserOutput, "TRY #"+fmt.Sprintf("%d", len(guesses)+1))
+		fmt.Fprintln(UserOutput, "------")
+		fmt.Fprintln(UserOutput)
+		if len(Answer) > 0 {
+			if len(guess) == 0 {
+				fmt.Fprintln(UserOutput, "No words left to guess.")
+				break
+			}
+			// Play the best guess against the answer instead of prompting for the guess and result.
+			result = words.ScoreGuess(Answer, guess)
+			fmt.Fprintln(UserOutput, "Guess:  '"+guess+"'")
+			fmt.Fprintln(UserOutput, "Result: '"+ResultFormat.Format(result)+"'")
+		} else {
+			if len(guesses) > 0 {
+				fmt.Fprintln(UserOutput, "Enter '"+UndoCommand+"' to undo the previous try or '"+EditCommand+" <try #>' to change an earlier try.")
+				fmt.Fprintln(UserOutput)
+			}
+			userGuess := guess
+			changedTries := false
+			for {
+				var err error
+				userGuess, err = getGuessOrCommand(userGuess)
+				if err != nil {
+					return
+				}
+				if userGuess == UndoCommand {
+					userGuess = guess
+					if len(guesses) == 0 {
+						fmt.Fprintln(UserOutput)
+						fmt.Fprintln(UserOutput, "Nothing to undo.")
+						fmt.Fprintln(UserOutput)
+						continue
+					}
+					guesses = guesses[:len(guesses)-1]
+					results = results[:len(results)-1]
+					changedTries = true
+					break
+				}
+				if strings.HasPrefix(userGuess, EditCommand) {
+					try := editTry(userGuess, len(guesses))
+					userGuess = guess
+					if try == 0 {
+						fmt.Fprintln(UserOutput)
+						fmt.Fprintln(UserOutput, "Please enter a try # between 1 and "+fmt.Sprintf("%d", len(guesses))+" to edit.")
+						fmt.Fprintln(UserOutput)
+						continue
+					}
+					fmt.Fprintln(UserOutput)
+					fmt.Fprintln(UserOutput, "Editing TRY #"+fmt.Sprintf("%d", try))
+					fmt.Fprintln(UserOutput)
+					editGuess := guesses[try-1]
+					for {
+						editGuess, err = getUserInputRange(editGuess, "Enter your Guess", "a", "z", "a-z", "", WordLength)
+						if err != nil {
+							return
+						}
+						editResult, correct, err := getConfirmedResult(editGuess, results[try-1], guesses, results, try)
+						if err != nil {
+							return
+						}
+						if correct {
+							guesses[try-1] = editGuess
+							results[try-1] = editResult
+							break
+						}
+					}
+					changedTries = true
+					break
+				}
+				userResult, correct, err := getConfirmedResult(userGuess, "", guesses, results, 0)
+				if err != nil {
+					return
+				}
+				if correct {
 					guess = userGuess
 					result = userResult
 					break
 				}
 			}
+
+			if changedTries {
+				// An edited try can be the solution, which ends the game the same as solving it on this try.
+				solvedTries := 0
+				for i := range results {
+					if isResultCorrect(results[i], WordLength) {
+						solvedTries = i + 1
+						break
+					}
+				}
+				if solvedTries == 0 {
+					printWordleSolution(guesses, results, false)
+					continue
+				}
+				guesses, results = guesses[:solvedTries], results[:solvedTries]
+				guess, result = guesses[solvedTries-1], results[solvedTries-1]
+				printWordleSolution(guesses, results, true)
+				if len(Answer) == 0 {
+					addUsedWord(guess, usedWords)
+				}
+				break
+			}
 		}
 
-		guesses[try] = guess
-		results[try] = result
+		guesses = append(guesses, guess)
+		results = append(results, result)
 
 		foundSolution := isResultCorrect(result, WordLength)
-		printWordleSolution(guesses, results, try, foundSolution)
+		printWordleSolution(guesses, results, foundSolution)
 		if foundSolution {
-			if DoWordle {
-				if !usedWords[guess] && !IgnoreWordleUsedWords {
-					addUsedWord := getUserInput(yes, "Would you like to add '"+guess+"' to the list of already used words?", yes+no, yes+" or "+no, "", 1)
-					if addUsedWord == yes {
-						f, err := os.OpenFile(UsedWordsFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-						if err != nil {
-							log.Println(err)
-						} else {
-							defer f.Close()
-							newUsedWord := "\n" + guess
-							_, err = f.WriteString(newUsedWord)
-							if err != nil {
-								log.Println(err)
-							}
-						}
+			if len(Answer) == 0 {
+				addUsedWord(guess, usedWords)
+			}
+			break
+		}
+	}
+	if len(Answer) > 0 && !isResultCorrect(result, WordLength) {
+		fmt.Fprintln(UserOutput)
+		fmt.Fprintln(UserOutput, "The answer '"+Answer+"' was not found in "+fmt.Sprintf("%d", len(guesses))+" turns.")
+		fmt.Fprintln(UserOutput)
+	}
+}
+
+func addUsedWord(guess string, usedWords map[string]bool) {
+	const (
+		yes = "y"
+		no  = "n"
+	)
+
+	if DoWordle {
+		if !usedWords[guess] && !IgnoreWordleUsedWords {
+			addUsedWord, err := getUserInput(yes, "Would you like to add '"+guess+"' to the list of already used words?", yes+no, yes+" or "+no, "", 1)
+			if err == nil && addUsedWord == yes {
+				f, err := os.OpenFile(UsedWordsFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+				if err != nil {
+					log.Println(err)
+				} else {
+					defer f.Close()
+					newUsedWord := "\n" + guess
+					_, err = f.WriteString(newUsedWord)
+					if err != nil {
+						log.Println(err)
 					}
 				}
 			}
-			break
 		}
 	}
 }
 
+// loadAnswer reads the -answer-file and checks that the answer is a word that auto can find.
+func loadAnswer(allWords []string) {
+	if len(AnswerFile) > 0 {
+		answerWords, err := readWordFile(AnswerFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if len(answerWords) == 0 {
+			fmt.Printf("\nERROR: '%s' does NOT include a %s letter word.\n\n", AnswerFile, formatWordLength(WordLength, MaxWordLength))
+			os.Exit(1)
+		}
+		Answer = answerWords[0]
+	}
+	if len(Answer) == 0 {
+		return
+	}
+	if UseTUI {
+		fmt.Printf("\nERROR: -%s and -%s can't be used with -%s.\n\n", AnswerFlag, AnswerFileFlag, TUIFlag)
+		os.Exit(1)
+	}
+
+	Answer = strings.ToLower(strings.TrimSpace(Answer))
+	for _, word := range allWords {
+		if word == Answer {
+			if len(AnswerFile) > 0 {
+				fmt.Printf("Playing against the answer in: %s\n", AnswerFile)
+			} else {
+				fmt.Printf("Playing against the answer: '%s'\n", Answer)
+			}
+			return
+		}
+	}
+	answerStr := "'" + Answer + "'"
+	if len(AnswerFile) > 0 {
+		answerStr = "The answer in '" + AnswerFile + "'"
+	}
+	fmt.Printf("\nERROR: %s is not one of the %s letter words, so it can't be found.\n\n", answerStr, formatWordLength(WordLength, MaxWordLength))
+	os.Exit(1)
+}
+
 func main() {
 	solutionWords, allWords, usedWords, guess, result := initialize()
 
@@ -842,7 +1645,38 @@ func main() {
 		WordSearch(solutionWords)
 	case ModeManualGuess:
 		ManualGuess(guess, result, solutionWords, allWords)
+	case ModeReview:
+		Review(solutionWords, allWords)
+	case ModeAnagram:
+		Anagram(solutionWords)
+	case ModeBee:
+		Bee(solutionWords)
+	case ModeLetterBoxed:
+		LetterBoxed(solutionWords)
+	case ModeWaffle:
+		Waffle(allWords)
+	case ModeHangman:
+		Hangman(solutionWords)
+	case ModeJotto:
+		Jotto(solutionWords, allWords)
+	case ModeLadder:
+		Ladder(allWords)
+	case ModeFibble:
+		Fibble(solutionWords, allWords)
+	case ModeXordle:
+		Xordle(solutionWords, allWords)
+	case ModeLingo:
+		Lingo(solutionWords, allWords)
+	case ModeNerdle:
+		Nerdle()
+	case ModeShell:
+		Shell(solutionWords, allWords)
 	default:
-		AutoPlay(guess, result, solutionWords, allWords, usedWords)
+		loadAnswer(allWords)
+		if UseTUI {
+			AutoPlayTUI(solutionWords, allWords, usedWords)
+		} else {
+			AutoPlay(guess, result, solutionWords, allWords, usedWords)
+		}
 	}
 }
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"wordtl/words"
+
+	"github.com/gookit/color"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// scriptReader returns one line of the script for each read, echoing it to the transcript as it is read like a terminal would.
+type scriptReader struct {
+	lines []string
+	out   io.Writer
+}
+
+func (r *scriptReader) Read(p []byte) (int, error) {
+	if len(r.lines) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, r.lines[0])
+	io.WriteString(r.out, r.lines[0][:n])
+	r.lines[0] = r.lines[0][n:]
+	if len(r.lines[0]) == 0 {
+		r.lines = r.lines[1:]
+	}
+	return n, nil
+}
+
+func newScriptReader(script string, out io.Writer) *scriptReader {
+	return &scriptReader{lines: strings.SplitAfter(script, "\n"), out: out}
+}
+
+// runAutoPlay plays the script of answers to the prompts, or against the answer when it is set, and returns everything written with the answers to the prompts echoed after each prompt.
+func runAutoPlay(t *testing.T, answer string, script string) string {
+	t.Helper()
+
+	var transcript bytes.Buffer
+	userInput, userOutput, colorEnable, oldAnswer := UserInput, UserOutput, color.Enable, Answer
+	defer func() {
+		UserInput, UserOutput, color.Enable, Answer = userInput, userOutput, colorEnable, oldAnswer
+	}()
+	UserOutput = &transcript
+	UserInput = bufio.NewReader(newScriptReader(script, &transcript))
+	color.Enable = false
+
+	WordLength = words.WordleLength
+	WordPattern = strings.Repeat(words.WildcardChar, WordLength)
+	WildcardLetters = ""
+	ExcludedLetters = ""
+	ExcludedByPosMap = map[int]string{}
+	MaxWordsToPrint = 10
+	ResultFormat = words.ResultFormats[words.DefaultResultFormat]
+	IgnoreWordleSolutionWords = false
+	DoWordle = false
+	Answer = answer
+
+	AutoPlay("", "", words.WordleSolutionWords, getWordleAllWords(), map[string]bool{})
+
+	return transcript.String()
+}
+
+func TestAutoPlay(t *testing.T) {
+	tests := []struct {
+		name   string
+		answer string
+		script string
+	}{
+		{
+			name:   "solved",
+			script: "\nx-x-x\n\n\nxx-xx\n\n\n===xx\n\n\n=====\n\n",
+		},
+		{
+			name:   "undo",
+			script: "\nx-x-x\n\n\nxxxxx\n\nn\n/undo\n\nxx-xx\n\n0\n",
+		},
+		{
+			name:   "edit",
+			script: "\nx-xxx\n\n/edit\n\nx-x-x\n\n/edit 2\n/edit 1\n\n\nn\n\n\n0\n",
+		},
+		{
+			name:   "edit solved",
+			script: "\nx-x-x\n\n/edit 1\n\n=====\n\n",
+		},
+		{
+			name:   "invalid input",
+			script: "crane\nx-x\nxyxxx\nxxxxx\nn\nxx-xx\n\n0\n",
+		},
+		{
+			name:   "exit",
+			script: "0\n",
+		},
+		{
+			name:   "end of input",
+			script: "crane\nxx-xx",
+		},
+		{
+			name:   "answer pilot",
+			answer: "pilot",
+		},
+		{
+			name:   "answer avert",
+			answer: "avert",
+		},
+		{
+			name:   "answer not in solution words",
+			answer: "cyton",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := runAutoPlay(t, tt.answer, tt.script)
+			golden := filepath.Join("testdata", "auto_"+strings.ReplaceAll(tt.name, " ", "_")+".golden")
+			if *update {
+				if err := ioutil.WriteFile(golden, []byte(got), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != string(want) {
+				t.Errorf("AutoPlay() transcript differs from %s, rerun with -update to see the changes:\n%s", golden, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"wordtl/words"
+)
+
+// Nerdle solves Nerdle, where the guesses are equations instead of words. The equations are encoded as letters so that
+// they can be matched and eliminated in the same way as words.
+func Nerdle() {
+	if WordLength < words.NerdleMinLength || WordLength > words.NerdleMaxLength {
+		fmt.Printf("\nERROR: Nerdle equations must be %d to %d characters long. Entered length is %d.\n\n", words.NerdleMinLength, words.NerdleMaxLength, WordLength)
+		os.Exit(1)
+	}
+	for _, operator := range NerdleOperators {
+		if !strings.ContainsRune(words.NerdleOperators, operator) || strings.Count(NerdleOperators, string(operator)) > 1 {
+			fmt.Println("Invalid -" + OperatorsFlag + ": '" + NerdleOperators + "' must only include each of '" + words.NerdleOperators + "' once, see usage with '" + os.Args[0] + " " + Mode + " -h'")
+			os.Exit(1)
+		}
+	}
+
+	equations := words.GetNerdleEquations(WordLength, NerdleOperators)
+	fmt.Printf("Valid equations: %d\n", len(equations))
+	isEquation := map[string]bool{}
+	encodedEquations := []string{}
+	for _, equation := range equations {
+		isEquation[equation] = true
+		encodedEquations = append(encodedEquations, words.EncodeNerdle(equation))
+	}
+
+	guesses, results := getGuessesAndResults()
+	encodedGuesses := []string{}
+	for _, guess := range guesses {
+		if !isEquation[guess] {
+			fmt.Printf("\nERROR: '%s' is not a valid equation.\n\n", guess)
+			os.Exit(1)
+		}
+		encodedGuesses = append(encodedGuesses, words.EncodeNerdle(guess))
+	}
+	WordPattern, WildcardLetters, ExcludedLetters, ExcludedByPosMap = words.TranslateAllGuessResults(encodedGuesses, results, WordPattern, ExcludedLetters, WildcardLetters, ExcludedByPosMap)
+
+	matchingWords, eliminationWords, bestEliminationWords := findWordSolutions(encodedEquations, encodedEquations)
+	printWords(decodeNerdleEquations(matchingWords), "MATCHING EQUATIONS", "EXACT MATCH", MaxWordsToPrint)
+	if len(matchingWords) > 1 {
+		remainingLetterCount, _ := words.GetLetterCount(matchingWords, WordPattern, WildcardLetters)
+		remainingSymbolCount := map[string]int{}
+		for letter, count := range remainingLetterCount {
+			// Every equation has exactly one '=', so it is never a symbol to try.
+			if symbol := words.DecodeNerdle(letter); symbol != words.NerdleEqualsChar {
+				remainingSymbolCount[symbol] = count
+			}
+		}
+		printLettersToTry(remainingSymbolCount)
+		if len(bestEliminationWords) > 1 {
+			printWords(decodeNerdleEquations(bestEliminationWords), "BEST ELIMINATION EQUATIONS", "BEST CHOICE", MaxWordsToPrint)
+		}
+	}
+	if guess := pickBestGuess(matchingWords, eliminationWords, bestEliminationWords); len(guess) > 0 {
+		fmt.Println()
+		fmt.Printf("BEST GUESS: '%s'\n", words.DecodeNerdle(guess))
+	}
+	fmt.Println()
+}
+
+func decodeNerdleEquations(encodedEquations []string) []string {
+	equations := []string{}
+	for _, encodedEquation := range encodedEquations {
+		equations = append(equations, words.DecodeNerdle(encodedEquation))
+	}
+	return equations
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"wordtl/words"
+)
+
+const MaxReviewScore = 99
+
+type reviewTurn struct {
+	guess            string
+	result           string
+	candidates       int
+	solverGuess      string
+	expectedGuess    float64
+	expectedSolver   float64
+	actualRemaining  int
+	skill            int
+	luck             int
+	solverCandidates []string
+}
+
+func getReviewGuessesAndResults() ([]string, []string) {
+	Answer = strings.ToLower(strings.TrimSpace(Answer))
+	if len(Answer) != WordLength {
+		fmt.Printf("\nERROR: -%s must be %d letters long. '%s' is %d lettters.\n\n", AnswerFlag, WordLength, Answer, len(Answer))
+		os.Exit(1)
+	}
+
+	guesses := []string{}
+	for _, guess := range strings.Split(Guesses, ",") {
+		guess = strings.ToLower(strings.TrimSpace(guess))
+		if len(guess) > 0 {
+			guesses = append(guesses, guess)
+		}
+	}
+	if len(guesses) == 0 {
+		fmt.Printf("\nERROR: -%s must include at least one guess.\n\n", GuessesFlag)
+		os.Exit(1)
+	}
+	for _, guess := range guesses {
+		if len(guess) != WordLength {
+			fmt.Printf("\nERROR: Guess must be %d letters long. '%s' is %d lettters.\n\n", WordLength, guess, len(guess))
+			os.Exit(1)
+		}
+	}
+
+	results := []string{}
+	if len(Results) > 0 {
+		for _, result := range strings.Split(Results, ",") {
+			results = append(results, strings.ToLower(strings.TrimSpace(result)))
+		}
+	} else if len(ShareText) > 0 {
+		results = words.ParseShareText(ShareText)
+	} else {
+		for _, guess := range guesses {
+			results = append(results, words.ScoreGuess(Answer, guess))
+		}
+	}
+	if len(results) != len(guesses) {
+		fmt.Printf("\nERROR: Found %d results for %d guesses.\n\n", len(results), len(guesses))
+		os.Exit(1)
+	}
+
+	for i, guess := range guesses {
+		if len(results[i]) != WordLength {
+			fmt.Printf("\nERROR: Result must be %d letters long. '%s' is %d lettters.\n\n", WordLength, results[i], len(results[i]))
+			os.Exit(1)
+		}
+		if expected := words.ScoreGuess(Answer, guess); results[i] != expected {
+			fmt.Printf("WARNING: Result '%s' for '%s' does not match the answer '%s', expected '%s'.\n", ResultFormat.Format(results[i]), guess, Answer, ResultFormat.Format(expected))
+		}
+	}
+
+	return guesses, results
+}
+
+// getSkillScore compares how many candidates the guess is expected to leave to the solver's guess and to a guess that eliminates nothing.
+func getSkillScore(candidates int, expectedGuess float64, expectedSolver float64) int {
+	if expectedGuess <= expectedSolver || float64(candidates) <= expectedSolver {
+		return MaxReviewScore
+	}
+	skill := MaxReviewScore * (float64(candidates) - expectedGuess) / (float64(candidates) - expectedSolver)
+	if skill < 0 {
+		return 0
+	}
+	return int(skill + 0.5)
+}
+
+// getLuckScore is the chance that the guess would have left more candidates than it actually did, counting ties as half.
+func getLuckScore(partitions map[string][]string, candidates int, actualRemaining int) int {
+	if candidates == 0 {
+		return 0
+	}
+	luck := 0.0
+	for _, partition := range partitions {
+		if len(partition) > actualRemaining {
+			luck += float64(len(partition))
+		} else if len(partition) == actualRemaining {
+			luck += float64(len(partition)) / 2
+		}
+	}
+	return int(MaxReviewScore*luck/float64(candidates) + 0.5)
+}
+
+func reviewGuess(guess string, result string, solutionWords []string, allWords []string) reviewTurn {
+	matchingWords, eliminationWords, bestEliminationWords := findWordSolutions(solutionWords, allWords)
+	turn := reviewTurn{
+		guess:            guess,
+		result:           result,
+		candidates:       len(matchingWords),
+		solverGuess:      pickBestGuess(matchingWords, eliminationWords, bestEliminationWords),
+		solverCandidates: matchingWords,
+	}
+
+	partitions := words.GetResultPartitions(matchingWords, guess)
+	turn.expectedGuess = words.GetExpectedRemaining(matchingWords, guess)
+	turn.expectedSolver = words.GetExpectedRemaining(matchingWords, turn.solverGuess)
+	for partitionResult, partition := range partitions {
+		if words.IsResultMatch(partitionResult, result) {
+			turn.actualRemaining += len(partition)
+		}
+	}
+	turn.skill = getSkillScore(turn.candidates, turn.expectedGuess, turn.expectedSolver)
+	turn.luck = getLuckScore(partitions, turn.candidates, turn.actualRemaining)
+	return turn
+}
+
+func printReviewTurn(try int, turn reviewTurn) {
+	fmt.Println()
+	fmt.Println("TRY #" + fmt.Sprintf("%d", try+1))
+	fmt.Println("------")
+	fmt.Println()
+	printWordleResult(turn.guess, turn.result)
+	fmt.Println()
+	fmt.Printf("Candidates before guess: %d\n", turn.candidates)
+	fmt.Printf("Your guess:      '%s' - expected remaining %.1f\n", turn.guess, turn.expectedGuess)
+	if len(turn.solverGuess) > 0 {
+		fmt.Printf("Solver's guess:  '%s' - expected remaining %.1f\n", turn.solverGuess, turn.expectedSolver)
+	}
+	fmt.Printf("Actual remaining: %d\n", turn.actualRemaining)
+	fmt.Printf("Skill: %d/%d  Luck: %d/%d\n", turn.skill, MaxReviewScore, turn.luck, MaxReviewScore)
+	if turn.candidates > 1 && turn.candidates <= MaxWordsToPrint {
+		printWords(turn.solverCandidates, "MATCHING WORDS", "", MaxWordsToPrint)
+	}
+}
+
+func Review(solutionWords []string, allWords []string) {
+	guesses, results := getReviewGuessesAndResults()
+
+	answerIsSolution := false
+	for _, word := range solutionWords {
+		if word == Answer {
+			answerIsSolution = true
+			break
+		}
+	}
+	if !answerIsSolution && len(solutionWords) != len(allWords) {
+		fmt.Printf("'%s' is not one of the solution words, reviewing with all words.\n", Answer)
+		IgnoreWordleSolutionWords = true
+		solutionWords = allWords
+	}
+
+	totalSkill := 0
+	totalLuck := 0
+	for try, guess := range guesses {
+		turn := reviewGuess(guess, results[try], solutionWords, allWords)
+		printReviewTurn(try, turn)
+		totalSkill += turn.skill
+		totalLuck += turn.luck
+		WordPattern, WildcardLetters, ExcludedLetters, ExcludedByPosMap = words.TranslateGuessResults(guess, results[try], WordPattern, ExcludedLetters, WildcardLetters, ExcludedByPosMap)
+	}
+
+	fmt.Println()
+	if isResultCorrect(results[len(results)-1], WordLength) {
+		fmt.Println("Solved '" + Answer + "' in " + fmt.Sprintf("%d", len(guesses)) + " turns.")
+	} else {
+		fmt.Println("Did not solve '" + Answer + "' in " + fmt.Sprintf("%d", len(guesses)) + " turns.")
+	}
+	fmt.Printf("Average Skill: %d/%d  Average Luck: %d/%d\n", totalSkill/len(guesses), MaxReviewScore, totalLuck/len(guesses), MaxReviewScore)
+	fmt.Println()
+}
@@ -0,0 +1,351 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"wordtl/words"
+
+	"golang.org/x/term"
+)
+
+const (
+	ShellPrompt     = "wordtl> "
+	ShellWordleDict = "wordle" // Name used with the dict command for the built-in Wordle words.
+)
+
+// shellCommand is a command that can be entered in the shell.
+type shellCommand struct {
+	name string
+	args string
+	help string
+	run  func(shell *wordShell, args []string)
+}
+
+var shellCommands []shellCommand
+
+func init() {
+	// Set in init, since the commands refer to shellCommands for help and completion.
+	shellCommands = []shellCommand{
+		{name: "guess", args: "<guess> <result>", help: "Add a guess and its result.", run: (*wordShell).addTry},
+		{name: "undo", help: "Remove the last guess.", run: (*wordShell).undoTry},
+		{name: "reset", help: "Remove all of the guesses.", run: (*wordShell).resetTries},
+		{name: "tries", help: "Show the guesses and results so far.", run: (*wordShell).showTries},
+		{name: "candidates", help: "Show the matching words and the best next guess.", run: (*wordShell).showCandidates},
+		{name: "search", args: "<pattern> [letters]", help: "Search all words for a pattern that includes the letters, ignoring the guesses.", run: (*wordShell).search},
+		{name: "stats", help: "Show the letters to try and the letter distribution of the matching words.", run: (*wordShell).showStats},
+		{name: "dict", args: "<file|" + ShellWordleDict + "> [length]", help: "Switch to the words in a file or the built-in Wordle words, which removes all of the guesses.", run: (*wordShell).switchDictionary},
+		{name: "help", help: "Show the commands.", run: (*wordShell).showHelp},
+		{name: "exit", help: "Leave the shell.", run: (*wordShell).exit},
+	}
+}
+
+// wordShell keeps the dictionary and the guesses between commands.
+type wordShell struct {
+	solutionWords []string
+	allWords      []string
+	guesses       []string
+	results       []string
+
+	// Constraints from the flags that the guesses are added to.
+	wordPattern      string
+	excludedLetters  string
+	wildcardLetters  string
+	excludedByPosMap map[int]string
+
+	done bool
+}
+
+// Shell runs commands one line at a time so that guesses can be added and searched without restarting.
+func Shell(solutionWords []string, allWords []string) {
+	shell := &wordShell{
+		solutionWords:    solutionWords,
+		allWords:         allWords,
+		wordPattern:      WordPattern,
+		excludedLetters:  ExcludedLetters,
+		wildcardLetters:  WildcardLetters,
+		excludedByPosMap: ExcludedByPosMap,
+	}
+
+	fmt.Fprintln(UserOutput)
+	fmt.Fprintln(UserOutput, "Enter 'help' to see the commands. Use Tab to complete commands and words, and Up/Down for history.")
+	readLine := getShellLineReader(shell)
+	for !shell.done {
+		line, err := readLine()
+		if err != nil {
+			if err != io.EOF {
+				fmt.Fprintf(UserOutput, "\nERROR: %s\n\n", err)
+			}
+			break
+		}
+		shell.runCommand(line)
+	}
+	fmt.Fprintln(UserOutput)
+}
+
+// getShellLineReader reads lines with history and Tab completion from a terminal, or plain lines from UserInput
+// when it is piped in or the output is not the terminal.
+func getShellLineReader(shell *wordShell) func() (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) || UserOutput != io.Writer(os.Stdout) {
+		return func() (string, error) {
+			line, err := UserInput.ReadString('\n')
+			if err != nil && (err != io.EOF || line == "") {
+				return "", err
+			}
+			line = strings.TrimSuffix(line, "\n")
+			return strings.TrimSuffix(line, "\r"), nil
+		}
+	}
+
+	terminal := term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{UserInput, UserOutput}, ShellPrompt)
+	terminal.AutoCompleteCallback = shell.complete
+	return func() (string, error) {
+		// Only use raw mode while reading, so the output of the commands is printed as usual.
+		state, err := term.MakeRaw(fd)
+		if err != nil {
+			return "", err
+		}
+		defer term.Restore(fd, state)
+		return terminal.ReadLine()
+	}
+}
+
+func (shell *wordShell) runCommand(line string) {
+	args := strings.Fields(line)
+	if len(args) == 0 {
+		return
+	}
+	for _, command := range shellCommands {
+		if command.name == strings.ToLower(args[0]) {
+			command.run(shell, args[1:])
+			return
+		}
+	}
+	fmt.Fprintln(UserOutput, "Unknown command '"+args[0]+"', enter 'help' to see the commands.")
+}
+
+// complete finishes the command or word before the cursor when Tab is pressed. With more than one choice, it
+// completes as much as they have in common.
+func (shell *wordShell) complete(line string, pos int, key rune) (string, int, bool) {
+	if key != '\t' {
+		return "", 0, false
+	}
+	start := strings.LastIndex(line[:pos], " ") + 1
+	prefix := strings.ToLower(line[start:pos])
+
+	choices := []string{}
+	if start == 0 {
+		for _, command := range shellCommands {
+			choices = append(choices, command.name)
+		}
+	} else if len(prefix) > 0 {
+		choices = shell.allWords
+	}
+	completion := ""
+	matches := 0
+	for _, choice := range choices {
+		if !strings.HasPrefix(choice, prefix) {
+			continue
+		}
+		if matches == 0 {
+			completion = choice
+		} else {
+			for !strings.HasPrefix(choice, completion) {
+				completion = completion[:len(completion)-1]
+			}
+		}
+		matches++
+	}
+	if matches == 0 || len(completion) <= len(prefix) && matches > 1 {
+		return "", 0, false
+	}
+	if matches == 1 {
+		completion += " "
+	}
+	return line[:start] + completion + line[pos:], start + len(completion), true
+}
+
+// updateConstraints rebuilds the constraints from every guess, so undoing a guess is the same as never entering it.
+func (shell *wordShell) updateConstraints() {
+	WordPattern, WildcardLetters, ExcludedLetters, ExcludedByPosMap = words.TranslateAllGuessResults(shell.guesses, shell.results, shell.wordPattern, shell.excludedLetters, shell.wildcardLetters, shell.excludedByPosMap)
+}
+
+func (shell *wordShell) addTry(args []string) {
+	if len(args) != 2 {
+		fmt.Fprintln(UserOutput, "Please enter a guess and its result, such as 'guess roate "+ResultFormat.Format("-x---")+"'.")
+		return
+	}
+	guess := strings.ToLower(args[0])
+	result := ResultFormat.Normalize(args[1])
+	if !isValidUserInput(guess, words.Alphabet, "a-z", "", WordLength) || !isValidUserInput(result, words.DefaultResultFormat+words.UnknownChar, ResultFormat.Name()+words.UnknownChar, "", WordLength) {
+		return
+	}
+	if conflicts := getTryConflicts(guess, result, shell.guesses, shell.results, 0); len(conflicts) > 0 {
+		fmt.Fprintln(UserOutput, "WARNING: This result conflicts with other tries:")
+		for _, conflict := range conflicts {
+			fmt.Fprintln(UserOutput, "   "+conflict)
+		}
+	}
+	shell.guesses = append(shell.guesses, guess)
+	shell.results = append(shell.results, result)
+	shell.updateConstraints()
+	fmt.Fprintf(UserOutput, "TRY #%d:\n", len(shell.guesses))
+	printWordleResult(guess, result)
+	if isResultCorrect(result, WordLength) {
+		printWordleSolution(shell.guesses, shell.results, true)
+		return
+	}
+	printKeyboard(WordPattern, WildcardLetters, ExcludedLetters)
+	matchingWords := words.GetMatchingWords(shell.solutionWords, WordPattern, ExcludedLetters, WildcardLetters, true, ExcludedByPosMap)
+	fmt.Fprintf(UserOutput, "\nMatching words: %d\n", len(matchingWords))
+}
+
+func (shell *wordShell) undoTry(args []string) {
+	if len(shell.guesses) == 0 {
+		fmt.Fprintln(UserOutput, "Nothing to undo.")
+		return
+	}
+	fmt.Fprintf(UserOutput, "Removed TRY #%d '%s'.\n", len(shell.guesses), shell.guesses[len(shell.guesses)-1])
+	shell.guesses = shell.guesses[:len(shell.guesses)-1]
+	shell.results = shell.results[:len(shell.results)-1]
+	shell.updateConstraints()
+}
+
+func (shell *wordShell) resetTries(args []string) {
+	shell.guesses = []string{}
+	shell.results = []string{}
+	shell.updateConstraints()
+	fmt.Fprintln(UserOutput, "Removed all of the guesses.")
+}
+
+func (shell *wordShell) showTries(args []string) {
+	if len(shell.guesses) == 0 {
+		fmt.Fprintln(UserOutput, "No guesses yet.")
+		return
+	}
+	for i := range shell.guesses {
+		fmt.Fprintf(UserOutput, "TRY #%d:\n", i+1)
+		printWordleResult(shell.guesses[i], shell.results[i])
+	}
+}
+
+func (shell *wordShell) showCandidates(args []string) {
+	shell.updateConstraints()
+	matchingWords, eliminationWords, bestEliminationWords := getWordSolutions(shell.solutionWords, shell.allWords)
+	if guess := getBestGuess(matchingWords, eliminationWords, bestEliminationWords); len(guess) > 0 {
+		fmt.Fprintln(UserOutput)
+		fmt.Fprintf(UserOutput, "BEST GUESS: '%s'\n", guess)
+	}
+}
+
+func (shell *wordShell) search(args []string) {
+	if len(args) == 0 || len(args) > 2 {
+		fmt.Fprintln(UserOutput, "Please enter a pattern and optional letters, such as 'search t"+strings.Repeat(words.WildcardChar, WordLength-1)+" ae'.")
+		return
+	}
+	wordPattern, excludedByPosMap, err := words.ParsePatternClasses(words.NormalizePattern(args[0]))
+	if err == nil && len(wordPattern) != WordLength {
+		err = fmt.Errorf("the pattern must be %d letters long", WordLength)
+	}
+	if err != nil {
+		fmt.Fprintln(UserOutput, "Invalid pattern: "+err.Error()+".")
+		return
+	}
+	wildcardLetters := ""
+	if len(args) == 2 {
+		wildcardLetters = strings.ToLower(args[1])
+	}
+	matchingWords := words.GetMatchingWords(shell.allWords, wordPattern, "", wildcardLetters, true, excludedByPosMap)
+	printWords(matchingWords, "SEARCH ALL WORDS", "EXACT MATCH", MaxWordsToPrint)
+}
+
+func (shell *wordShell) showStats(args []string) {
+	shell.updateConstraints()
+	matchingWords := words.GetMatchingWords(shell.solutionWords, WordPattern, ExcludedLetters, WildcardLetters, true, ExcludedByPosMap)
+	fmt.Fprintf(UserOutput, "Words: %d solution words, %d words in all\n", len(shell.solutionWords), len(shell.allWords))
+	fmt.Fprintf(UserOutput, "Guesses: %d\n", len(shell.guesses))
+	fmt.Fprintf(UserOutput, "Matching words: %d\n", len(matchingWords))
+	remainingLetterCount, _ := words.GetLetterCount(matchingWords, WordPattern, WildcardLetters)
+	printLettersToTry(remainingLetterCount)
+	printWordDiagnostics(words.GetLetterDistribution(matchingWords, WordLength), WordLength)
+}
+
+func (shell *wordShell) switchDictionary(args []string) {
+	if len(args) == 0 || len(args) > 2 {
+		fmt.Fprintln(UserOutput, "Please enter a word file or '"+ShellWordleDict+"', and an optional word length.")
+		return
+	}
+	wordLength := WordLength
+	if len(args) == 2 {
+		var err error
+		if wordLength, err = strconv.Atoi(args[1]); err != nil || wordLength < MinWordLength {
+			fmt.Fprintf(UserOutput, "The word length must be a number greater than %d.\n", MinWordLength-1)
+			return
+		}
+	}
+
+	var solutionWords, allWords []string
+	if args[0] == ShellWordleDict {
+		if wordLength != words.WordleLength {
+			fmt.Fprintf(UserOutput, "The %s words are %d letters long.\n", WordleTitle, words.WordleLength)
+			return
+		}
+		allWords = getWordleAllWords()
+		for _, word := range words.WordleSolutionWords {
+			solutionWords = append(solutionWords, strings.ToLower(word))
+		}
+		WordFile = ""
+	} else {
+		previousWordLength := WordLength
+		WordLength, MaxWordLength = wordLength, wordLength
+		var err error
+		allWords, err = readWordFile(args[0])
+		WordLength, MaxWordLength = previousWordLength, previousWordLength
+		if err != nil {
+			fmt.Fprintln(UserOutput, "Unable to read the words: "+err.Error())
+			return
+		}
+		if len(allWords) == 0 {
+			fmt.Fprintf(UserOutput, "'%s' does NOT include any %d letter words.\n", args[0], wordLength)
+			return
+		}
+		solutionWords = allWords
+		WordFile = args[0]
+	}
+
+	WordLength, MaxWordLength = wordLength, wordLength
+	shell.solutionWords, shell.allWords = solutionWords, allWords
+	shell.guesses, shell.results = []string{}, []string{}
+	shell.wordPattern = strings.Repeat(words.WildcardChar, WordLength)
+	shell.excludedLetters, shell.wildcardLetters, shell.excludedByPosMap = "", "", map[int]string{}
+	shell.updateConstraints()
+	fmt.Fprintf(UserOutput, "Using %d solution words and %d words in all, %d letters long.\n", len(solutionWords), len(allWords), WordLength)
+}
+
+func (shell *wordShell) showHelp(args []string) {
+	commands := make([]string, 0, len(shellCommands))
+	for _, command := range shellCommands {
+		commands = append(commands, strings.TrimSpace(command.name+" "+command.args))
+	}
+	spacing := 0
+	for _, command := range commands {
+		if len(command) > spacing {
+			spacing = len(command)
+		}
+	}
+	for i, command := range shellCommands {
+		fmt.Fprintln(UserOutput, "   "+commands[i]+strings.Repeat(" ", spacing-len(commands[i])+3)+command.help)
+	}
+	fmt.Fprintln(UserOutput)
+	fmt.Fprintln(UserOutput, "Results use '"+ResultFormat.Matched+"', '"+ResultFormat.Wildcard+"', '"+ResultFormat.Missed+"' and '"+words.UnknownChar+"', see -"+ResultFormatFlag+". Patterns are the same as the -"+WordPatternFlag+" flag.")
+}
+
+func (shell *wordShell) exit(args []string) {
+	shell.done = true
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"wordtl/words"
+
+	"github.com/gookit/color"
+)
+
+var shellWords = []string{"aback", "bland", "caper", "civic", "crane", "stamp"}
+
+// runShell runs the script of commands in a shell with the shell words and returns everything it printed.
+func runShell(t *testing.T, script string) string {
+	t.Helper()
+
+	var output bytes.Buffer
+	userInput, userOutput, colorEnable := UserInput, UserOutput, color.Enable
+	wordLength, maxWordLength, wordFile := WordLength, MaxWordLength, WordFile
+	defer func() {
+		UserInput, UserOutput, color.Enable = userInput, userOutput, colorEnable
+		WordLength, MaxWordLength, WordFile = wordLength, maxWordLength, wordFile
+	}()
+	UserOutput = &output
+	UserInput = bufio.NewReader(strings.NewReader(script))
+	color.Enable = false
+
+	WordLength, MaxWordLength = words.WordleLength, words.WordleLength
+	WordPattern = strings.Repeat(words.WildcardChar, WordLength)
+	WildcardLetters = ""
+	ExcludedLetters = ""
+	ExcludedByPosMap = map[int]string{}
+	MaxWordsToPrint = 10
+	ResultFormat = words.ResultFormats[words.DefaultResultFormat]
+
+	Shell(shellWords, shellWords)
+
+	return output.String()
+}
+
+func TestShell(t *testing.T) {
+	keyboard := "\nQ   W   Ex  Rx  T   Y   U   I   O   P   \n  A=  S   D   F   G   H   J   K   L   \n    Z   X   Cx  V   B   Nx  M   \n('=' is in position, '-' is out of position, 'x' is not in the word)\n"
+	tests := []struct {
+		name   string
+		script string
+		want   string
+	}{
+		{
+			name:   "Guess Then Candidates",
+			script: "guess crane xx=xx\ncandidates\n",
+			want:   "TRY #1:\n C   R   A   N   E \n" + keyboard + "\nMatching words: 1\n\nMATCHING WORDS - EXACT MATCH! - 'stamp'\n\nUsing MATCHING WORD - 'stamp'\n\nBEST GUESS: 'stamp'\n",
+		},
+		{
+			name:   "Undo Rebuilds the Candidates",
+			script: "guess crane xx=xx\nguess tumps xxxxx\nundo\ncandidates\n",
+			want: "TRY #1:\n C   R   A   N   E \n" + keyboard + "\nMatching words: 1\nTRY #2:\n T   U   M   P   S \n" +
+				"\nQ   W   Ex  Rx  Tx  Y   Ux  I   O   Px  \n  A=  Sx  D   F   G   H   J   K   L   \n    Z   X   Cx  V   B   Nx  Mx  \n('=' is in position, '-' is out of position, 'x' is not in the word)\n" +
+				"\nMatching words: 0\nRemoved TRY #2 'tumps'.\n\nMATCHING WORDS - EXACT MATCH! - 'stamp'\n\nUsing MATCHING WORD - 'stamp'\n\nBEST GUESS: 'stamp'\n",
+		},
+		{
+			name:   "Undo and Reset",
+			script: "undo\nguess crane xx=xx\nundo\ntries\nguess crane xx=xx\nreset\ntries\n",
+			want:   "Nothing to undo.\nTRY #1:\n C   R   A   N   E \n" + keyboard + "\nMatching words: 1\nRemoved TRY #1 'crane'.\nNo guesses yet.\nTRY #1:\n C   R   A   N   E \n" + keyboard + "\nMatching words: 1\nRemoved all of the guesses.\nNo guesses yet.\n",
+		},
+		{
+			name:   "Unknown Command and Exit",
+			script: "bogus\nexit\ntries\n",
+			want:   "Unknown command 'bogus', enter 'help' to see the commands.\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := "\nEnter 'help' to see the commands. Use Tab to complete commands and words, and Up/Down for history.\n" + tt.want + "\n"
+			if got := runShell(t, tt.script); got != want {
+				t.Errorf("Shell() with script %q printed %q, want %q", tt.script, got, want)
+			}
+		})
+	}
+}
+
+func TestShellDict(t *testing.T) {
+	wordFile := filepath.Join(t.TempDir(), "words.txt")
+	if err := ioutil.WriteFile(wordFile, []byte("able\nbake\ncrane\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		script string
+		want   string
+	}{
+		{
+			name:   "Word File",
+			script: "guess crane xx=xx\ndict " + wordFile + " 4\ntries\nsearch ---e\n",
+			want:   "Using 2 solution words and 2 words in all, 4 letters long.\nNo guesses yet.\n\nSEARCH ALL WORDS (2):\nable bake \n",
+		},
+		{
+			name:   "Wordle Words Length",
+			script: "dict " + ShellWordleDict + " 4\n",
+			want:   "The Wordle words are 5 letters long.\n",
+		},
+		{
+			name:   "Invalid Length",
+			script: "dict " + wordFile + " four\n",
+			want:   "The word length must be a number greater than 2.\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := runShell(t, tt.script)
+			// Only compare what the dict command and the commands after it printed.
+			if i := strings.Index(got, "Using "); i >= 0 {
+				got = got[i:]
+			} else if i := strings.Index(got, "history.\n"); i >= 0 {
+				got = got[i+len("history.\n"):]
+			}
+			if want := tt.want + "\n"; got != want {
+				t.Errorf("Shell() with script %q printed %q, want %q", tt.script, got, want)
+			}
+		})
+	}
+}
+
+func TestShellComplete(t *testing.T) {
+	tests := []struct {
+		name   string
+		line   string
+		key    rune
+		want   string
+		wantOk bool
+	}{
+		{
+			name:   "Command",
+			line:   "gu",
+			key:    '\t',
+			want:   "guess ",
+			wantOk: true,
+		},
+		{
+			name: "Commands in Common",
+			line: "s",
+			key:  '\t',
+		},
+		{
+			name:   "Word",
+			line:   "guess cr",
+			key:    '\t',
+			want:   "guess crane ",
+			wantOk: true,
+		},
+		{
+			name:   "Words in Common",
+			line:   "guess ca",
+			key:    '\t',
+			want:   "guess caper ",
+			wantOk: true,
+		},
+		{
+			name: "No Matching Word",
+			line: "guess xy",
+			key:  '\t',
+		},
+		{
+			name: "Not Tab",
+			line: "gu",
+			key:  'e',
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shell := &wordShell{allWords: shellWords}
+			got, gotPos, gotOk := shell.complete(tt.line, len(tt.line), tt.key)
+			if gotOk != tt.wantOk {
+				t.Fatalf("complete(%q) ok = %v, want %v", tt.line, gotOk, tt.wantOk)
+			}
+			if got != tt.want || gotOk && gotPos != len(tt.want) {
+				t.Errorf("complete(%q) = %q, %d, want %q, %d", tt.line, got, gotPos, tt.want, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestShellSearch(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{
+			name: "Vowel and Consonant Shorthand",
+			line: "search CVCVC",
+			want: "SEARCH ALL WORDS (2):\ncaper civic \n",
+		},
+		{
+			name: "Capital Word",
+			line: "search CRANE",
+			want: "SEARCH ALL WORDS - EXACT MATCH! - 'crane'\n",
+		},
+		{
+			name: "Shorthand and Wildcard Letters",
+			line: "search CV--- r",
+			want: "SEARCH ALL WORDS - EXACT MATCH! - 'caper'\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var output bytes.Buffer
+			userOutput, wordLength := UserOutput, WordLength
+			defer func() {
+				UserOutput, WordLength = userOutput, wordLength
+			}()
+			UserOutput = &output
+			WordLength = 5
+
+			shell := &wordShell{allWords: []string{"crane", "caper", "civic", "aback"}}
+			shell.runCommand(tt.line)
+			if got := strings.TrimPrefix(output.String(), "\n"); got != tt.want {
+				t.Errorf("runCommand(%q) printed %q, want %q", tt.line, got, tt.want)
+			}
+		})
+	}
+}
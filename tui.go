@@ -0,0 +1,406 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"wordtl/words"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+const (
+	tuiTileWidth   = 3
+	tuiTileSpacing = 1
+	tuiRowSpacing  = 2
+	tuiMargin      = 2
+)
+
+var (
+	tuiHelp = []string{
+		"Type or click letters, Left/Right pick a tile, Up/Down/Space or click it to change colour.",
+		"Tab uses the suggestion, Enter submits the row, Ctrl-Z undoes the previous row, Esc quits.",
+	}
+
+	tuiDefaultStyle  = tcell.StyleDefault
+	tuiMatchedStyle  = tcell.StyleDefault.Background(tcell.ColorGreen).Foreground(tcell.ColorWhite).Bold(true)
+	tuiWildcardStyle = tcell.StyleDefault.Background(tcell.ColorOlive).Foreground(tcell.ColorWhite).Bold(true)
+	tuiMissedStyle   = tcell.StyleDefault.Background(tcell.ColorDimGray).Foreground(tcell.ColorWhite).Bold(true)
+	tuiUntestedStyle = tcell.StyleDefault.Background(tcell.ColorSilver).Foreground(tcell.ColorBlack).Bold(true)
+	tuiUnknownStyle  = tcell.StyleDefault.Background(tcell.ColorPurple).Foreground(tcell.ColorWhite).Bold(true)
+	tuiTitleStyle    = tcell.StyleDefault.Bold(true)
+)
+
+type autoPlayTUI struct {
+	screen        tcell.Screen
+	solutionWords []string
+	allWords      []string
+	usedWords     map[string]bool
+
+	// Constraints from the command line that every row is applied to.
+	wordPattern      string
+	excludedLetters  string
+	wildcardLetters  string
+	excludedByPosMap map[int]string
+
+	guesses []string
+	results []string
+	guess   []byte
+	result  []byte
+	cursor  int
+
+	matchingWords        []string
+	eliminationWords     []string
+	bestEliminationWords []string
+	suggestion           string
+	message              string
+	solved               bool
+	buttons              tcell.ButtonMask
+}
+
+func AutoPlayTUI(solutionWords []string, allWords []string, usedWords map[string]bool) {
+	screen, err := tcell.NewScreen()
+	if err == nil {
+		err = screen.Init()
+	}
+	if err != nil {
+		fmt.Printf("\nERROR: Unable to start the terminal UI: %s\n\n", err)
+		os.Exit(1)
+	}
+
+	tui := &autoPlayTUI{
+		screen:           screen,
+		solutionWords:    solutionWords,
+		allWords:         allWords,
+		usedWords:        usedWords,
+		wordPattern:      WordPattern,
+		excludedLetters:  ExcludedLetters,
+		wildcardLetters:  WildcardLetters,
+		excludedByPosMap: ExcludedByPosMap,
+	}
+	tui.resetRow()
+	tui.updateSolutions()
+
+	screen.EnableMouse()
+	tui.run()
+	screen.Fini()
+
+	if tui.solved {
+		printWordleSolution(tui.guesses, tui.results, true)
+		addUsedWord(tui.guesses[len(tui.guesses)-1], usedWords)
+	}
+}
+
+func (tui *autoPlayTUI) run() {
+	for {
+		tui.draw()
+		switch event := tui.screen.PollEvent().(type) {
+		case *tcell.EventResize:
+			tui.screen.Sync()
+		case *tcell.EventKey:
+			if !tui.handleKey(event) {
+				return
+			}
+		case *tcell.EventMouse:
+			// Only act when the button is first pressed, not while it is held down.
+			if event.Buttons()&tcell.Button1 != 0 && tui.buttons&tcell.Button1 == 0 {
+				tui.handleClick(event.Position())
+			}
+			tui.buttons = event.Buttons()
+		}
+	}
+}
+
+func (tui *autoPlayTUI) isGameOver() bool {
+	return tui.solved || len(tui.guesses) >= MaxTries
+}
+
+func (tui *autoPlayTUI) resetRow() {
+	tui.guess = []byte{}
+	tui.result = []byte(strings.Repeat(words.MissedChar, WordLength))
+	tui.cursor = 0
+}
+
+// updateSolutions rebuilds the constraints from every submitted row, so undoing a row is the same as never entering it.
+func (tui *autoPlayTUI) updateSolutions() {
+	WordPattern, WildcardLetters, ExcludedLetters, ExcludedByPosMap = words.TranslateAllGuessResults(tui.guesses, tui.results, tui.wordPattern, tui.excludedLetters, tui.wildcardLetters, tui.excludedByPosMap)
+	tui.matchingWords, tui.eliminationWords, tui.bestEliminationWords = findWordSolutions(tui.solutionWords, tui.allWords)
+	if len(tui.matchingWords) == 0 && len(tui.solutionWords) != len(tui.allWords) {
+		tui.message = "No matching words found in Solution Words, searching All Words."
+		IgnoreWordleSolutionWords = true
+		tui.solutionWords = tui.allWords
+		tui.matchingWords, tui.eliminationWords, tui.bestEliminationWords = findWordSolutions(tui.solutionWords, tui.allWords)
+	}
+	tui.suggestion = pickBestGuess(tui.matchingWords, tui.eliminationWords, tui.bestEliminationWords)
+}
+
+func (tui *autoPlayTUI) handleKey(event *tcell.EventKey) bool {
+	tui.message = ""
+	switch event.Key() {
+	case tcell.KeyEscape, tcell.KeyCtrlC:
+		return false
+	case tcell.KeyCtrlZ:
+		tui.undo()
+	case tcell.KeyEnter:
+		if tui.isGameOver() {
+			return false
+		}
+		tui.submit()
+	case tcell.KeyTab:
+		if !tui.isGameOver() && len(tui.suggestion) == WordLength {
+			tui.guess = []byte(tui.suggestion)
+			tui.cursor = 0
+		}
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		if len(tui.guess) > 0 {
+			tui.guess = tui.guess[:len(tui.guess)-1]
+			tui.result[len(tui.guess)] = words.MissedChar[0]
+			if tui.cursor >= len(tui.guess) && tui.cursor > 0 {
+				tui.cursor--
+			}
+		}
+	case tcell.KeyLeft:
+		if tui.cursor > 0 {
+			tui.cursor--
+		}
+	case tcell.KeyRight:
+		if tui.cursor < WordLength-1 {
+			tui.cursor++
+		}
+	case tcell.KeyUp:
+		tui.cycleResult(tui.cursor, false)
+	case tcell.KeyDown:
+		tui.cycleResult(tui.cursor, true)
+	case tcell.KeyRune:
+		if event.Rune() == ' ' {
+			tui.cycleResult(tui.cursor, true)
+		} else {
+			tui.typeLetter(event.Rune())
+		}
+	}
+	return true
+}
+
+func (tui *autoPlayTUI) typeLetter(letter rune) {
+	letter = []rune(strings.ToLower(string(letter)))[0]
+	if tui.isGameOver() || letter < 'a' || letter > 'z' || len(tui.guess) >= WordLength {
+		return
+	}
+	tui.guess = append(tui.guess, byte(letter))
+	tui.cursor = len(tui.guess) - 1
+}
+
+// cycleResult steps the tile through the missed, wildcard and matched colours, then unknown for a tile whose colour
+// is not known.
+func (tui *autoPlayTUI) cycleResult(position int, forward bool) {
+	if tui.isGameOver() || position >= len(tui.guess) {
+		return
+	}
+	order := words.MissedChar + words.WildcardChar + words.MatchedChar + words.UnknownChar
+	step := 1
+	if !forward {
+		step = len(order) - 1
+	}
+	next := (strings.IndexByte(order, tui.result[position]) + step) % len(order)
+	tui.result[position] = order[next]
+	tui.cursor = position
+}
+
+func (tui *autoPlayTUI) submit() {
+	if len(tui.guess) != WordLength {
+		tui.message = fmt.Sprintf("Guess must be %d letters long.", WordLength)
+		return
+	}
+	conflicts := getTryConflicts(string(tui.guess), string(tui.result), tui.guesses, tui.results, 0)
+	tui.guesses = append(tui.guesses, string(tui.guess))
+	tui.results = append(tui.results, string(tui.result))
+	tui.solved = isResultCorrect(string(tui.result), WordLength)
+	tui.resetRow()
+	tui.updateSolutions()
+	if len(conflicts) > 0 {
+		tui.message = "WARNING: " + conflicts[0] + " Press Ctrl-Z to fix the row."
+	} else if tui.solved {
+		tui.message = fmt.Sprintf("Congratulations, you have found the solution word in %d turns! Press Enter to exit.", len(tui.guesses))
+	} else if tui.isGameOver() {
+		tui.message = "Out of tries. Press Ctrl-Z to undo the previous row or Enter to exit."
+	}
+}
+
+// undo moves the previous row back into the current row so it can be corrected.
+func (tui *autoPlayTUI) undo() {
+	if len(tui.guesses) == 0 {
+		tui.message = "Nothing to undo."
+		return
+	}
+	last := len(tui.guesses) - 1
+	tui.guess = []byte(tui.guesses[last])
+	tui.result = []byte(tui.results[last])
+	tui.cursor = 0
+	tui.guesses = tui.guesses[:last]
+	tui.results = tui.results[:last]
+	tui.solved = false
+	tui.updateSolutions()
+}
+
+func (tui *autoPlayTUI) handleClick(x int, y int) {
+	tui.message = ""
+	row := len(tui.guesses)
+	rowY := tuiMargin + 2 + row*tuiRowSpacing
+	if y == rowY {
+		for position := 0; position < WordLength; position++ {
+			tileX := tuiMargin + position*(tuiTileWidth+tuiTileSpacing)
+			if x >= tileX && x < tileX+tuiTileWidth {
+				tui.cycleResult(position, true)
+				return
+			}
+		}
+	}
+
+	keyboardY := tui.keyboardY()
+	for row, keys := range KeyboardRows {
+		if y != keyboardY+row*tuiRowSpacing {
+			continue
+		}
+		for i, key := range keys {
+			keyX := tuiMargin + row*tuiRowSpacing + i*(tuiTileWidth+tuiTileSpacing)
+			if x >= keyX && x < keyX+tuiTileWidth {
+				tui.typeLetter(key)
+				return
+			}
+		}
+	}
+}
+
+func (tui *autoPlayTUI) keyboardY() int {
+	return tuiMargin + 2 + MaxTries*tuiRowSpacing + 1
+}
+
+func (tui *autoPlayTUI) resultStyle(result byte) tcell.Style {
+	switch string(result) {
+	case words.MatchedChar:
+		return tuiMatchedStyle
+	case words.WildcardChar:
+		return tuiWildcardStyle
+	case words.UnknownChar:
+		return tuiUnknownStyle
+	default:
+		return tuiMissedStyle
+	}
+}
+
+// letterStyle colours a key with the best state known for the letter from the current constraints. A tile whose
+// colour is not known adds nothing to the constraints, so its letter keeps the colour it had before.
+func (tui *autoPlayTUI) letterStyle(letterStates map[string]string, letter rune) tcell.Style {
+	switch letterStates[string(letter)] {
+	case words.MatchedChar:
+		return tuiMatchedStyle
+	case words.WildcardChar:
+		return tuiWildcardStyle
+	case words.MissedChar:
+		return tuiMissedStyle
+	default:
+		return tuiUntestedStyle
+	}
+}
+
+func (tui *autoPlayTUI) drawText(x int, y int, style tcell.Style, text string) int {
+	width, _ := tui.screen.Size()
+	for _, char := range text {
+		if x >= width {
+			break
+		}
+		tui.screen.SetContent(x, y, char, nil, style)
+		x++
+	}
+	return x
+}
+
+// drawWrapped draws the words across as many lines as fit between x and the right edge of the screen.
+func (tui *autoPlayTUI) drawWrapped(x int, y int, maxY int, style tcell.Style, text []string) int {
+	width, _ := tui.screen.Size()
+	lineX := x
+	for i, word := range text {
+		if lineX+len(word) >= width && lineX > x {
+			lineX = x
+			y++
+		}
+		if y >= maxY {
+			tui.drawText(x, y, style, fmt.Sprintf("... %d more", len(text)-i))
+			return y + 1
+		}
+		lineX = tui.drawText(lineX, y, style, word) + 1
+	}
+	return y + 1
+}
+
+func (tui *autoPlayTUI) drawTile(x int, y int, style tcell.Style, letter string) {
+	tui.drawText(x, y, style, " "+strings.ToUpper(letter)+" ")
+}
+
+func (tui *autoPlayTUI) draw() {
+	tui.screen.Clear()
+	width, height := tui.screen.Size()
+
+	tui.drawText(tuiMargin, tuiMargin, tuiTitleStyle, getModeDescription(ModeAutoPlay))
+
+	// Tile grid.
+	for row := 0; row < MaxTries; row++ {
+		y := tuiMargin + 2 + row*tuiRowSpacing
+		for position := 0; position < WordLength; position++ {
+			x := tuiMargin + position*(tuiTileWidth+tuiTileSpacing)
+			switch {
+			case row < len(tui.guesses):
+				tui.drawTile(x, y, tui.resultStyle(tui.results[row][position]), string(tui.guesses[row][position]))
+			case row == len(tui.guesses) && position < len(tui.guess):
+				style := tui.resultStyle(tui.result[position])
+				if position == tui.cursor {
+					style = style.Underline(true).Reverse(true)
+				}
+				tui.drawTile(x, y, style, string(tui.guess[position]))
+			default:
+				style := tuiDefaultStyle.Dim(true)
+				if row == len(tui.guesses) && position == len(tui.guess) && !tui.isGameOver() {
+					style = tuiDefaultStyle.Underline(true)
+				}
+				tui.drawText(x, y, style, " _ ")
+			}
+		}
+	}
+
+	// On-screen keyboard.
+	keyboardY := tui.keyboardY()
+	letterStates := words.GetLetterStates(WordPattern, WildcardLetters, ExcludedLetters)
+	for row, keys := range KeyboardRows {
+		for i, key := range keys {
+			x := tuiMargin + row*tuiRowSpacing + i*(tuiTileWidth+tuiTileSpacing)
+			tui.drawTile(x, keyboardY+row*tuiRowSpacing, tui.letterStyle(letterStates, key), string(key))
+		}
+	}
+
+	// Side panel with candidates and suggestions.
+	panelX := tuiMargin + len(KeyboardRows[0])*(tuiTileWidth+tuiTileSpacing) + tuiMargin
+	panelMaxY := height - len(tuiHelp) - 2
+	y := tuiMargin + 2
+	if panelX < width {
+		if len(tui.suggestion) > 0 {
+			tui.drawText(panelX, y, tuiTitleStyle, "SUGGESTION: '"+tui.suggestion+"' (Tab to use)")
+		} else {
+			tui.drawText(panelX, y, tuiTitleStyle, "No suggestion!")
+		}
+		y += 2
+		tui.drawText(panelX, y, tuiTitleStyle, fmt.Sprintf("MATCHING WORDS (%d):", len(tui.matchingWords)))
+		y = tui.drawWrapped(panelX, y+1, panelMaxY/2, tuiDefaultStyle, tui.matchingWords) + 1
+		if len(tui.matchingWords) > 1 && len(tui.bestEliminationWords) > 0 {
+			tui.drawText(panelX, y, tuiTitleStyle, fmt.Sprintf("BEST ELIMINATION WORDS (%d):", len(tui.bestEliminationWords)))
+			tui.drawWrapped(panelX, y+1, panelMaxY, tuiDefaultStyle, tui.bestEliminationWords)
+		}
+	}
+
+	// Status and help lines.
+	tui.drawText(tuiMargin, height-len(tuiHelp)-1, tuiTitleStyle, tui.message)
+	for i, help := range tuiHelp {
+		tui.drawText(tuiMargin, height-len(tuiHelp)+i, tuiDefaultStyle.Dim(true), help)
+	}
+
+	tui.screen.Show()
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"wordtl/words"
+
+	"github.com/gookit/color"
+)
+
+func Waffle(allWords []string) {
+	if WordLength != words.WaffleSize {
+		fmt.Printf("\nERROR: Waffle words must be %d letters long. Entered word length is %d.\n\n", words.WaffleSize, WordLength)
+		os.Exit(1)
+	}
+	grid, resultGrid, err := words.ParseWaffle(strings.TrimSpace(Letters), strings.TrimSpace(TileResults))
+	if err != nil {
+		fmt.Println("Invalid -" + LettersFlag + " or -" + TileResultsFlag + ": " + err.Error() + ", see usage with '" + os.Args[0] + " " + Mode + " -h'")
+		os.Exit(1)
+	}
+
+	fmt.Println()
+	fmt.Println("Waffle Puzzle:")
+	printWaffleGrid(grid, resultGrid)
+
+	solutions := words.SolveWaffle(allWords, grid, resultGrid, 2)
+	if len(solutions) == 0 {
+		fmt.Println()
+		fmt.Println("NO SOLUTION. Please check the -" + LettersFlag + " and -" + TileResultsFlag + " args.")
+		fmt.Println()
+		return
+	}
+	if len(solutions) > 1 {
+		fmt.Println()
+		fmt.Println("More than one solution matches the puzzle, using the first one.")
+	}
+	solution := solutions[0]
+
+	fmt.Println()
+	fmt.Println("Waffle Solution:")
+	solvedResultGrid := ""
+	for index := range solution {
+		if words.IsWaffleTile(index) {
+			solvedResultGrid += words.MatchedChar
+		} else {
+			solvedResultGrid += words.WaffleHoleChar
+		}
+	}
+	printWaffleGrid(solution, solvedResultGrid)
+
+	swaps := words.GetWaffleSwaps(grid, solution)
+	fmt.Println()
+	for i, swap := range swaps {
+		fmt.Printf("Swap #%d: '%s' %s with '%s' %s\n", i+1, strings.ToUpper(grid[swap.From:swap.From+1]), getWaffleTileName(swap.From), strings.ToUpper(grid[swap.To:swap.To+1]), getWaffleTileName(swap.To))
+		grid = words.ApplyWaffleSwaps(grid, []words.WaffleSwap{swap})
+	}
+	fmt.Println()
+	if len(swaps) <= words.WaffleMaxSwaps {
+		fmt.Printf("SOLVED in %d swaps with %d swaps to spare!\n", len(swaps), words.WaffleMaxSwaps-len(swaps))
+	} else {
+		fmt.Printf("SOLVED in %d swaps, which is more than the %d swaps allowed.\n", len(swaps), words.WaffleMaxSwaps)
+	}
+	fmt.Println()
+}
+
+func getWaffleTileName(index int) string {
+	return fmt.Sprintf("(row %d, column %d)", index/words.WaffleSize+1, index%words.WaffleSize+1)
+}
+
+func printWaffleGrid(grid string, resultGrid string) {
+	match := color.New(color.BgGreen, color.Bold)
+	almost := color.New(color.BgLightYellow, color.Bold)
+	miss := color.New(color.BgDarkGray, color.Bold)
+
+	for index := 0; index < len(grid); index++ {
+		char := " " + strings.ToUpper(grid[index:index+1]) + " "
+		switch resultGrid[index : index+1] {
+		case words.MatchedChar:
+			match.Print(char)
+		case words.WildcardChar:
+			almost.Print(char)
+		case words.MissedChar:
+			miss.Print(char)
+		default:
+			fmt.Print("   ")
+		}
+		if index%words.WaffleSize < words.WaffleSize-1 {
+			fmt.Print(" ")
+		} else {
+			fmt.Println()
+		}
+	}
+}
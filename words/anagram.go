@@ -0,0 +1,44 @@
+package words
+
+import (
+	"strings"
+)
+
+const BlankChar = "?"
+
+// CanMakeWord reports whether word can be spelled from letters, where a blank '?' is any letter.
+func CanMakeWord(word string, letters string) bool {
+	letterCount := map[rune]int{}
+	for _, letter := range letters {
+		letterCount[letter]++
+	}
+	blanks := letterCount[rune(BlankChar[0])]
+
+	for _, letter := range word {
+		if letterCount[letter] > 0 {
+			letterCount[letter]--
+		} else if blanks > 0 {
+			blanks--
+		} else {
+			return false
+		}
+	}
+	return true
+}
+
+// GetAnagramWords returns the words that can be spelled from letters.
+func GetAnagramWords(words []string, letters string, useAllLetters bool) []string {
+	var anagramWords []string
+
+	letters = strings.ToLower(letters)
+	for _, word := range words {
+		if useAllLetters && len(word) != len(letters) {
+			continue
+		}
+		if len(word) <= len(letters) && CanMakeWord(word, letters) {
+			anagramWords = append(anagramWords, word)
+		}
+	}
+
+	return anagramWords
+}
@@ -0,0 +1,97 @@
+package words
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCanMakeWord(t *testing.T) {
+	type args struct {
+		word    string
+		letters string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "All Letters",
+			args: args{word: "tarot", letters: "rotta"},
+			want: true,
+		},
+		{
+			name: "Some Letters",
+			args: args{word: "tar", letters: "rotta"},
+			want: true,
+		},
+		{
+			name: "Letter Used Too Many Times",
+			args: args{word: "tarot", letters: "rota"},
+			want: false,
+		},
+		{
+			name: "Missing Letter",
+			args: args{word: "avert", letters: "tarot"},
+			want: false,
+		},
+		{
+			name: "Blanks Fill Missing Letters",
+			args: args{word: "avert", letters: "tar??"},
+			want: true,
+		},
+		{
+			name: "Not Enough Blanks",
+			args: args{word: "avert", letters: "tar?"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CanMakeWord(tt.args.word, tt.args.letters); got != tt.want {
+				t.Errorf("CanMakeWord() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetAnagramWords(t *testing.T) {
+	type args struct {
+		words         []string
+		letters       string
+		useAllLetters bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "No Matches",
+			args: args{words: []string{"avert", "tabor"}, letters: "rotta", useAllLetters: false},
+			want: nil,
+		},
+		{
+			name: "Any Number of Letters",
+			args: args{words: []string{"tar", "rat", "tarot", "tabor", "otter"}, letters: "ROTTA", useAllLetters: false},
+			want: []string{"tar", "rat", "tarot"},
+		},
+		{
+			name: "Use All Letters",
+			args: args{words: []string{"tar", "rat", "tarot", "tabor", "otter"}, letters: "rotta", useAllLetters: true},
+			want: []string{"tarot"},
+		},
+		{
+			name: "Use All Letters With Blank",
+			args: args{words: []string{"tar", "rat", "tarot", "tabor", "otter"}, letters: "rott?", useAllLetters: true},
+			want: []string{"tarot", "otter"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetAnagramWords(tt.args.words, tt.args.letters, tt.args.useAllLetters); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetAnagramWords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
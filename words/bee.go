@@ -0,0 +1,58 @@
+package words
+
+import (
+	"strings"
+)
+
+const (
+	BeeMinWordLength = 4
+	BeePangramBonus  = 7
+)
+
+// IsBeeWord reports whether word is a Spelling Bee answer for the letters and center letter.
+func IsBeeWord(word string, letters string, centerLetter string) bool {
+	if len(word) < BeeMinWordLength || !strings.Contains(word, centerLetter) {
+		return false
+	}
+	for _, letter := range word {
+		if !strings.ContainsRune(letters, letter) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsPangram reports whether word uses every one of the letters.
+func IsPangram(word string, letters string) bool {
+	for _, letter := range letters {
+		if !strings.ContainsRune(word, letter) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetBeeScore returns the NYT points for a Spelling Bee answer.
+func GetBeeScore(word string, letters string) int {
+	score := len(word)
+	if len(word) == BeeMinWordLength {
+		score = 1
+	}
+	if IsPangram(word, letters) {
+		score += BeePangramBonus
+	}
+	return score
+}
+
+// GetBeeWords returns the Spelling Bee answers in words.
+func GetBeeWords(words []string, letters string, centerLetter string) []string {
+	var beeWords []string
+
+	for _, word := range words {
+		if IsBeeWord(word, letters, centerLetter) {
+			beeWords = append(beeWords, word)
+		}
+	}
+
+	return beeWords
+}
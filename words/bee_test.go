@@ -0,0 +1,91 @@
+package words
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsBeeWord(t *testing.T) {
+	type args struct {
+		word         string
+		letters      string
+		centerLetter string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "Too Short",
+			args: args{word: "pit", letters: "ptiaonc", centerLetter: "t"},
+			want: false,
+		},
+		{
+			name: "Missing Center Letter",
+			args: args{word: "coin", letters: "ptiaonc", centerLetter: "t"},
+			want: false,
+		},
+		{
+			name: "Letter Not In Puzzle",
+			args: args{word: "taps", letters: "ptiaonc", centerLetter: "t"},
+			want: false,
+		},
+		{
+			name: "Repeated Letters",
+			args: args{word: "tint", letters: "ptiaonc", centerLetter: "t"},
+			want: true,
+		},
+		{
+			name: "Pangram",
+			args: args{word: "caption", letters: "ptiaonc", centerLetter: "t"},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsBeeWord(tt.args.word, tt.args.letters, tt.args.centerLetter); got != tt.want {
+				t.Errorf("IsBeeWord() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetBeeScore(t *testing.T) {
+	tests := []struct {
+		name string
+		word string
+		want int
+	}{
+		{
+			name: "Four Letters",
+			word: "tint",
+			want: 1,
+		},
+		{
+			name: "Longer Word",
+			word: "potion",
+			want: 6,
+		},
+		{
+			name: "Pangram",
+			word: "caption",
+			want: 14,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetBeeScore(tt.word, "ptiaonc"); got != tt.want {
+				t.Errorf("GetBeeScore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetBeeWords(t *testing.T) {
+	words := []string{"pit", "coin", "taps", "tint", "potion", "caption", "optic"}
+	want := []string{"tint", "potion", "caption", "optic"}
+	if got := GetBeeWords(words, "ptiaonc", "t"); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetBeeWords() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,102 @@
+package words
+
+import (
+	"strings"
+)
+
+// Emoji used by the Wordle "Share" button for each tile colour.
+const (
+	ShareMatchedTile      = "🟩"
+	ShareWildcardTile     = "🟨"
+	ShareMissedTile       = "⬛"
+	ShareMissedLightTile  = "⬜"
+	ShareMatchedHighTile  = "🟧" // High contrast mode.
+	ShareWildcardHighTile = "🟦" // High contrast mode.
+)
+
+// ScoreGuess returns the Wordle result for guess when answer is the solution.
+func ScoreGuess(answer string, guess string) string {
+	answer = strings.ToLower(answer)
+	guess = strings.ToLower(guess)
+	if len(answer) != len(guess) {
+		return ""
+	}
+
+	result := []byte(strings.Repeat(MissedChar, len(guess)))
+	unmatched := map[byte]int{}
+	for i := 0; i < len(guess); i++ {
+		if guess[i] == answer[i] {
+			result[i] = MatchedChar[0]
+		} else {
+			unmatched[answer[i]]++
+		}
+	}
+	for i := 0; i < len(guess); i++ {
+		if result[i] != MatchedChar[0] && unmatched[guess[i]] > 0 {
+			result[i] = WildcardChar[0]
+			unmatched[guess[i]]--
+		}
+	}
+	return string(result)
+}
+
+// Scorer returns the feedback for guess when answer is the solution, such as ScoreGuess for Wordle.
+type Scorer func(answer string, guess string) string
+
+// GetResultPartitions groups the candidate words by the result that guess would produce if each one was the solution.
+func GetResultPartitions(candidates []string, guess string) map[string][]string {
+	return GetScorePartitions(candidates, guess, ScoreGuess)
+}
+
+// GetScorePartitions groups the candidates by the feedback that guess would get from scorer.
+func GetScorePartitions(candidates []string, guess string, scorer Scorer) map[string][]string {
+	partitions := map[string][]string{}
+	for _, candidate := range candidates {
+		result := scorer(candidate, guess)
+		partitions[result] = append(partitions[result], candidate)
+	}
+	return partitions
+}
+
+// GetExpectedRemaining returns the average number of candidates left after guess.
+func GetExpectedRemaining(candidates []string, guess string) float64 {
+	return GetScoreExpectedRemaining(candidates, guess, ScoreGuess)
+}
+
+// GetScoreExpectedRemaining is GetExpectedRemaining for the feedback from scorer.
+func GetScoreExpectedRemaining(candidates []string, guess string, scorer Scorer) float64 {
+	if len(candidates) == 0 {
+		return 0
+	}
+	total := 0
+	for _, partition := range GetScorePartitions(candidates, guess, scorer) {
+		total += len(partition) * len(partition)
+	}
+	return float64(total) / float64(len(candidates))
+}
+
+// ParseShareText translates the emoji rows from the Wordle "Share" button into results, ignoring any other text.
+func ParseShareText(shareText string) []string {
+	tiles := map[string]string{
+		ShareMatchedTile:      MatchedChar,
+		ShareMatchedHighTile:  MatchedChar,
+		ShareWildcardTile:     WildcardChar,
+		ShareWildcardHighTile: WildcardChar,
+		ShareMissedTile:       MissedChar,
+		ShareMissedLightTile:  MissedChar,
+	}
+
+	results := []string{}
+	for _, line := range strings.Fields(shareText) {
+		result := ""
+		for _, tile := range line {
+			if char, ok := tiles[string(tile)]; ok {
+				result += char
+			}
+		}
+		if len(result) > 0 {
+			results = append(results, result)
+		}
+	}
+	return results
+}
@@ -0,0 +1,127 @@
+package words
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScoreGuess(t *testing.T) {
+	type args struct {
+		answer string
+		guess  string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Different Lengths",
+			args: args{answer: "avert", guess: "roat"},
+			want: "",
+		},
+		{
+			name: "Match All",
+			args: args{answer: "avert", guess: "avert"},
+			want: "=====",
+		},
+		{
+			name: "Match Some, Others In Wrong Position",
+			args: args{answer: "avert", guess: "roate"},
+			want: "-x---",
+		},
+		{
+			name: "Repeated Guess Letter, Single Answer Letter",
+			args: args{answer: "chalk", guess: "sleek"},
+			want: "x-xx=",
+		},
+		{
+			name: "Repeated Guess Letter, Only First Marked",
+			args: args{answer: "abbey", guess: "keeps"},
+			want: "x-xxx",
+		},
+		{
+			name: "Repeated Guess Letter, Matched Later In Word",
+			args: args{answer: "those", guess: "geese"},
+			want: "xxx==",
+		},
+		{
+			name: "Repeated Letter In Both",
+			args: args{answer: "llama", guess: "label"},
+			want: "=-xx-",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ScoreGuess(tt.args.answer, tt.args.guess); got != tt.want {
+				t.Errorf("ScoreGuess() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetExpectedRemaining(t *testing.T) {
+	type args struct {
+		candidates []string
+		guess      string
+	}
+	tests := []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "No Candidates",
+			args: args{candidates: []string{}, guess: "roate"},
+			want: 0,
+		},
+		{
+			name: "Every Candidate Separated",
+			args: args{candidates: []string{"avert", "great", "tread", "treat"}, guess: "gived"},
+			want: 1,
+		},
+		{
+			name: "Some Candidates Separated",
+			args: args{candidates: []string{"avert", "great", "tread", "treat"}, guess: "fjord"},
+			want: 1.5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetExpectedRemaining(tt.args.candidates, tt.args.guess); got != tt.want {
+				t.Errorf("GetExpectedRemaining() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseShareText(t *testing.T) {
+	tests := []struct {
+		name      string
+		shareText string
+		want      []string
+	}{
+		{
+			name:      "Empty",
+			shareText: "",
+			want:      []string{},
+		},
+		{
+			name:      "Share Text With Header",
+			shareText: "Wordle 1,234 3/6\n\n🟨⬛🟨🟨🟨\n⬛⬛🟩⬛⬛\n🟩🟩🟩🟩🟩",
+			want:      []string{"-x---", "xx=xx", "====="},
+		},
+		{
+			name:      "High Contrast On One Line",
+			shareText: "🟦⬜🟦🟦🟦 🟧🟧🟧🟧🟧",
+			want:      []string{"-x---", "====="},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseShareText(tt.shareText); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseShareText() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package words
+
+import (
+	"strings"
+)
+
+// CountLies returns the number of known tiles where the shown result is not the true result.
+func CountLies(trueResult string, shownResult string) int {
+	lies := 0
+	for i := 0; i < len(trueResult) && i < len(shownResult); i++ {
+		if trueResult[i] != shownResult[i] && shownResult[i:i+1] != UnknownChar {
+			lies++
+		}
+	}
+	return lies
+}
+
+// IsFibbleMatch reports whether word could be the answer when every result has exactly lies wrong tiles.
+func IsFibbleMatch(word string, guesses []string, results []string, lies int) bool {
+	for i, guess := range guesses {
+		if len(word) != len(guess) {
+			return false
+		}
+		knownLies := CountLies(ScoreGuess(word, guess), results[i])
+		if knownLies > lies || knownLies < lies-strings.Count(results[i], UnknownChar) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetFibbleMatchingWords returns the words that could be the answer.
+func GetFibbleMatchingWords(words []string, guesses []string, results []string, lies int) []string {
+	var matchingWords []string
+
+	for _, word := range words {
+		if IsFibbleMatch(word, guesses, results, lies) {
+			matchingWords = append(matchingWords, word)
+		}
+	}
+
+	return matchingWords
+}
+
+// getLieResults returns every result that could be shown for the true result with exactly the number of lies.
+func getLieResults(result string, lies int) []string {
+	if lies == 0 {
+		return []string{result}
+	}
+	lieResults := []string{}
+	for i := 0; i < len(result); i++ {
+		for _, lie := range MatchedChar + WildcardChar + MissedChar {
+			if byte(lie) == result[i] {
+				continue
+			}
+			// Only lie about tiles after this one so that each set of lied tiles is only counted once.
+			for _, rest := range getLieResults(result[i+1:], lies-1) {
+				lieResults = append(lieResults, result[:i]+string(lie)+rest)
+			}
+		}
+	}
+	return lieResults
+}
+
+// GetFibbleExpectedRemaining returns the average number of candidates left after guess with lies in the result.
+func GetFibbleExpectedRemaining(candidates []string, guess string, lies int) float64 {
+	if len(candidates) == 0 {
+		return 0
+	}
+	// A shown result can come from any true result with the same number of lies, so every candidate with one of those
+	// true results is left.
+	partitions := GetResultPartitions(candidates, guess)
+	lieResults := map[string][]string{}
+	remaining := map[string]int{}
+	for trueResult, partition := range partitions {
+		lieResults[trueResult] = getLieResults(trueResult, lies)
+		for _, shownResult := range lieResults[trueResult] {
+			remaining[shownResult] += len(partition)
+		}
+	}
+
+	total := 0.0
+	for trueResult, partition := range partitions {
+		shownRemaining := 0
+		for _, shownResult := range lieResults[trueResult] {
+			shownRemaining += remaining[shownResult]
+		}
+		total += float64(len(partition)) * float64(shownRemaining) / float64(len(lieResults[trueResult]))
+	}
+	return total / float64(len(candidates))
+}
+
+// GetBestFibbleGuesses sorts the guess words by GetFibbleExpectedRemaining, fewest first.
+func GetBestFibbleGuesses(candidates []string, guessWords []string, lies int) ([]string, map[string]float64) {
+	return sortByExpectedRemaining(candidates, guessWords, func(guess string) float64 {
+		return GetFibbleExpectedRemaining(candidates, guess, lies)
+	})
+}
@@ -0,0 +1,77 @@
+package words
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestCountLies(t *testing.T) {
+	if got := CountLies("-x---", "-x=-x"); got != 2 {
+		t.Errorf("CountLies() = %v, want 2", got)
+	}
+}
+
+func TestGetLieResults(t *testing.T) {
+	got := getLieResults("=x", 1)
+	sort.Strings(got)
+	want := []string{"-x", "=-", "==", "xx"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("getLieResults() = %v, want %v", got, want)
+	}
+	if got := getLieResults("=====", 2); len(got) != 40 {
+		t.Errorf("getLieResults() = %v results, want 40", len(got))
+	}
+}
+
+func TestGetFibbleMatchingWords(t *testing.T) {
+	words := []string{"avert", "alert", "overt", "tabor"}
+	tests := []struct {
+		name    string
+		guesses []string
+		results []string
+		lies    int
+		want    []string
+	}{
+		{
+			name:    "True Result Is Not A Match",
+			guesses: []string{"roate"},
+			results: []string{ScoreGuess("avert", "roate")},
+			lies:    1,
+			want:    nil,
+		},
+		{
+			name:    "One Lie",
+			guesses: []string{"roate"},
+			results: []string{"-x=--"},
+			lies:    1,
+			want:    []string{"avert", "alert"},
+		},
+		{
+			name:    "No Lies",
+			guesses: []string{"roate"},
+			results: []string{"-x---"},
+			lies:    0,
+			want:    []string{"avert", "alert"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetFibbleMatchingWords(words, tt.guesses, tt.results, tt.lies); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetFibbleMatchingWords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetFibbleExpectedRemaining(t *testing.T) {
+	candidates := []string{"avert", "alert", "overt", "tabor"}
+	// With no lies, it is the same as Wordle.
+	if got, want := GetFibbleExpectedRemaining(candidates, "alert", 0), GetExpectedRemaining(candidates, "alert"); got != want {
+		t.Errorf("GetFibbleExpectedRemaining() = %v, want %v", got, want)
+	}
+	// A lie can make different true results look the same, so more candidates are left.
+	if got := GetFibbleExpectedRemaining(candidates, "alert", 1); got < GetExpectedRemaining(candidates, "alert") {
+		t.Errorf("GetFibbleExpectedRemaining() = %v, want at least %v", got, GetExpectedRemaining(candidates, "alert"))
+	}
+}
@@ -0,0 +1,114 @@
+package words
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// HangmanLetter is how useful a letter is to guess next.
+type HangmanLetter struct {
+	Letter      string
+	Information float64 // Expected bits of information from the positions the letter is revealed in.
+	HitRate     float64 // Fraction of the words that have the letter.
+}
+
+// GetHangmanWords returns the words that match the revealed pattern and have none of the missed letters.
+func GetHangmanWords(words []string, pattern string, missedLetters string) []string {
+	revealedLetters := strings.ReplaceAll(pattern, WildcardChar, "")
+	excludedByPosMap := map[int]string{}
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i:i+1] == WildcardChar {
+			excludedByPosMap[i+1] = revealedLetters
+		}
+	}
+	return GetMatchingWords(words, pattern, missedLetters, "", false, excludedByPosMap)
+}
+
+// GetHangmanLetters returns the untried letters, best guess first.
+func GetHangmanLetters(words []string, triedLetters string) []HangmanLetter {
+	hangmanLetters := []HangmanLetter{}
+	if len(words) == 0 {
+		return hangmanLetters
+	}
+
+	for _, letter := range Alphabet {
+		if strings.ContainsRune(triedLetters, letter) {
+			continue
+		}
+		partitions := map[string]int{}
+		hits := 0
+		for _, word := range words {
+			positions := ""
+			for i, wordLetter := range word {
+				if wordLetter == letter {
+					positions += string(rune('0' + i))
+				}
+			}
+			partitions[positions]++
+			if len(positions) > 0 {
+				hits++
+			}
+		}
+		if hits == 0 {
+			continue
+		}
+		information := 0.0
+		for _, count := range partitions {
+			probability := float64(count) / float64(len(words))
+			information -= probability * math.Log2(probability)
+		}
+		hangmanLetters = append(hangmanLetters, HangmanLetter{
+			Letter:      string(letter),
+			Information: information,
+			HitRate:     float64(hits) / float64(len(words)),
+		})
+	}
+
+	sort.SliceStable(hangmanLetters, func(i, j int) bool {
+		if hangmanLetters[i].Information != hangmanLetters[j].Information {
+			return hangmanLetters[i].Information > hangmanLetters[j].Information
+		}
+		return hangmanLetters[i].HitRate > hangmanLetters[j].HitRate
+	})
+	return hangmanLetters
+}
+
+// PlayHangman plays a game against answer and returns the missed letters.
+func PlayHangman(answer string, words []string, bestLetters map[string]string) string {
+	pattern := strings.Repeat(WildcardChar, len(answer))
+	missedLetters := ""
+
+	for strings.Contains(pattern, WildcardChar) {
+		// bestLetters can be shared between games, so the letter for each pattern and missed letters is only worked out once.
+		key := pattern + MissedChar + missedLetters
+		letter, found := bestLetters[key]
+		if !found {
+			hangmanLetters := GetHangmanLetters(GetHangmanWords(words, pattern, missedLetters), pattern+missedLetters)
+			if len(hangmanLetters) == 0 {
+				// The answer is not one of the words, so stop guessing.
+				break
+			}
+			letter = hangmanLetters[0].Letter
+			if bestLetters != nil {
+				bestLetters[key] = letter
+			}
+		}
+
+		if !strings.Contains(answer, letter) {
+			missedLetters += letter
+			continue
+		}
+		revealed := ""
+		for i := 0; i < len(answer); i++ {
+			if answer[i:i+1] == letter {
+				revealed += letter
+			} else {
+				revealed += pattern[i : i+1]
+			}
+		}
+		pattern = revealed
+	}
+
+	return missedLetters
+}
@@ -0,0 +1,77 @@
+package words
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetHangmanWords(t *testing.T) {
+	type args struct {
+		words         []string
+		pattern       string
+		missedLetters string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "Nothing Revealed",
+			args: args{words: []string{"tabor", "tarot", "avert"}, pattern: "-----", missedLetters: ""},
+			want: []string{"tabor", "tarot", "avert"},
+		},
+		{
+			name: "Revealed Letter Is In Every Position",
+			args: args{words: []string{"tabor", "tarot", "avert"}, pattern: "t----", missedLetters: ""},
+			want: []string{"tabor"},
+		},
+		{
+			name: "Missed Letters",
+			args: args{words: []string{"tabor", "tarot", "avert", "teeth"}, pattern: "t---t", missedLetters: "e"},
+			want: []string{"tarot"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetHangmanWords(tt.args.words, tt.args.pattern, tt.args.missedLetters); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetHangmanWords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetHangmanLetters(t *testing.T) {
+	words := []string{"cat", "bat", "hat", "cot"}
+	got := GetHangmanLetters(words, "t")
+	// 'c' splits the words in half, 'a' is in more words than the other letters with the same information.
+	if len(got) != 5 || got[0].Letter != "c" || got[1].Letter != "a" {
+		t.Fatalf("GetHangmanLetters() = %v, want 'c' then 'a' first", got)
+	}
+	if got[0].Information != 1 {
+		t.Errorf("GetHangmanLetters() 'c' Information = %v, want 1", got[0].Information)
+	}
+	if got[1].HitRate != 0.75 {
+		t.Errorf("GetHangmanLetters() 'a' HitRate = %v, want 0.75", got[1].HitRate)
+	}
+	for _, hangmanLetter := range got {
+		if hangmanLetter.Letter == "t" || hangmanLetter.Letter == "z" {
+			t.Errorf("GetHangmanLetters() includes '%s', which is tried or not in any word", hangmanLetter.Letter)
+		}
+	}
+}
+
+func TestPlayHangman(t *testing.T) {
+	words := []string{"cat", "bat", "hat", "cot"}
+	bestLetters := map[string]string{}
+	for _, answer := range words {
+		missedLetters := PlayHangman(answer, words, bestLetters)
+		if len(missedLetters) > 2 {
+			t.Errorf("PlayHangman(%s) missed '%s', want 2 or fewer misses", answer, missedLetters)
+		}
+	}
+	// A word that is not in the list stops when no words are left to guess from.
+	if got := PlayHangman("dog", words, nil); got != "cba" {
+		t.Errorf("PlayHangman(dog) missed '%s', want 'cba'", got)
+	}
+}
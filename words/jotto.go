@@ -0,0 +1,114 @@
+package words
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const BullsCowsSeparator = "/"
+
+// ScoreJotto returns the number of letters that guess shares with answer.
+func ScoreJotto(answer string, guess string) string {
+	return strconv.Itoa(countSharedLetters(answer, guess))
+}
+
+// ScoreBullsAndCows returns the bulls and cows for guess, such as '1/2'.
+func ScoreBullsAndCows(answer string, guess string) string {
+	bulls := 0
+	for i := 0; i < len(guess) && i < len(answer); i++ {
+		if guess[i] == answer[i] {
+			bulls++
+		}
+	}
+	return fmt.Sprintf("%d%s%d", bulls, BullsCowsSeparator, countSharedLetters(answer, guess)-bulls)
+}
+
+// ParseScore validates a score for the word length and returns it in its normal form.
+func ParseScore(score string, wordLength int, bullsAndCows bool) (string, error) {
+	if !bullsAndCows {
+		shared, err := strconv.Atoi(score)
+		if err != nil || shared < 0 || shared > wordLength {
+			return "", fmt.Errorf("must be a number of shared letters from 0 to %d such as '2'", wordLength)
+		}
+		return strconv.Itoa(shared), nil
+	}
+
+	counts := strings.Split(score, BullsCowsSeparator)
+	if len(counts) == 2 {
+		bulls, bullsErr := strconv.Atoi(counts[0])
+		cows, cowsErr := strconv.Atoi(counts[1])
+		if bullsErr == nil && cowsErr == nil && bulls >= 0 && cows >= 0 && bulls+cows <= wordLength {
+			return fmt.Sprintf("%d%s%d", bulls, BullsCowsSeparator, cows), nil
+		}
+	}
+	return "", fmt.Errorf("must be bulls%scows adding up to no more than %d such as '1%s2'", BullsCowsSeparator, wordLength, BullsCowsSeparator)
+}
+
+func countSharedLetters(answer string, guess string) int {
+	answerLetters := map[rune]int{}
+	for _, letter := range answer {
+		answerLetters[letter]++
+	}
+	shared := 0
+	for _, letter := range guess {
+		if answerLetters[letter] > 0 {
+			answerLetters[letter]--
+			shared++
+		}
+	}
+	return shared
+}
+
+// GetScoredMatchingWords returns the words that would have gotten every score.
+func GetScoredMatchingWords(words []string, guesses []string, scores []string, scorer Scorer) []string {
+	var matchingWords []string
+
+	for _, word := range words {
+		match := true
+		for i, guess := range guesses {
+			if len(word) != len(guess) || scorer(word, guess) != scores[i] {
+				match = false
+				break
+			}
+		}
+		if match {
+			matchingWords = append(matchingWords, word)
+		}
+	}
+
+	return matchingWords
+}
+
+// GetBestScoredGuesses sorts the guess words by the expected number of candidates left, fewest first.
+func GetBestScoredGuesses(candidates []string, guessWords []string, scorer Scorer) ([]string, map[string]float64) {
+	return sortByExpectedRemaining(candidates, guessWords, func(guess string) float64 {
+		return GetScoreExpectedRemaining(candidates, guess, scorer)
+	})
+}
+
+func sortByExpectedRemaining(candidates []string, guessWords []string, getExpectedRemaining func(guess string) float64) ([]string, map[string]float64) {
+	isCandidate := map[string]bool{}
+	for _, candidate := range candidates {
+		isCandidate[candidate] = true
+	}
+
+	expectedRemaining := map[string]float64{}
+	bestGuesses := []string{}
+	for _, guess := range guessWords {
+		if _, found := expectedRemaining[guess]; found {
+			continue
+		}
+		expectedRemaining[guess] = getExpectedRemaining(guess)
+		bestGuesses = append(bestGuesses, guess)
+	}
+
+	sort.SliceStable(bestGuesses, func(i, j int) bool {
+		if expectedRemaining[bestGuesses[i]] != expectedRemaining[bestGuesses[j]] {
+			return expectedRemaining[bestGuesses[i]] < expectedRemaining[bestGuesses[j]]
+		}
+		return isCandidate[bestGuesses[i]] && !isCandidate[bestGuesses[j]]
+	})
+	return bestGuesses, expectedRemaining
+}
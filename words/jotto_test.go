@@ -0,0 +1,193 @@
+package words
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScoreJotto(t *testing.T) {
+	tests := []struct {
+		name   string
+		answer string
+		guess  string
+		want   string
+	}{
+		{
+			name:   "No Shared Letters",
+			answer: "avert",
+			guess:  "pilly",
+			want:   "0",
+		},
+		{
+			name:   "Shared Letters In Any Position",
+			answer: "avert",
+			guess:  "roate",
+			want:   "4",
+		},
+		{
+			name:   "Repeated Letters Counted Once Each",
+			answer: "abbey",
+			guess:  "keeps",
+			want:   "1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ScoreJotto(tt.answer, tt.guess); got != tt.want {
+				t.Errorf("ScoreJotto() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScoreBullsAndCows(t *testing.T) {
+	tests := []struct {
+		name   string
+		answer string
+		guess  string
+		want   string
+	}{
+		{
+			name:   "Bulls and Cows",
+			answer: "avert",
+			guess:  "roate",
+			want:   "0/4",
+		},
+		{
+			name:   "All Bulls",
+			answer: "avert",
+			guess:  "avert",
+			want:   "5/0",
+		},
+		{
+			name:   "Repeated Letters",
+			answer: "llama",
+			guess:  "label",
+			want:   "1/2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ScoreBullsAndCows(tt.answer, tt.guess); got != tt.want {
+				t.Errorf("ScoreBullsAndCows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseScore(t *testing.T) {
+	tests := []struct {
+		name         string
+		score        string
+		bullsAndCows bool
+		want         string
+		wantErr      bool
+	}{
+		{
+			name:  "Shared Letters",
+			score: "2",
+			want:  "2",
+		},
+		{
+			name:  "Leading Zero",
+			score: "04",
+			want:  "4",
+		},
+		{
+			name:    "More Than Word Length",
+			score:   "6",
+			wantErr: true,
+		},
+		{
+			name:    "Negative",
+			score:   "-1",
+			wantErr: true,
+		},
+		{
+			name:    "Not a Number",
+			score:   "two",
+			wantErr: true,
+		},
+		{
+			name:         "Bulls and Cows",
+			score:        "1/02",
+			bullsAndCows: true,
+			want:         "1/2",
+		},
+		{
+			name:         "Bulls and Cows More Than Word Length",
+			score:        "3/3",
+			bullsAndCows: true,
+			wantErr:      true,
+		},
+		{
+			name:         "Bulls and Cows Without Cows",
+			score:        "3",
+			bullsAndCows: true,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseScore(tt.score, 5, tt.bullsAndCows)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseScore() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseScore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetScoredMatchingWords(t *testing.T) {
+	words := []string{"avert", "alert", "overt", "tabor", "pilly"}
+	tests := []struct {
+		name    string
+		guesses []string
+		scores  []string
+		scorer  Scorer
+		want    []string
+	}{
+		{
+			name:    "Jotto",
+			guesses: []string{"roate", "pilly"},
+			scores:  []string{"4", "0"},
+			scorer:  ScoreJotto,
+			want:    []string{"avert", "overt", "tabor"},
+		},
+		{
+			name:    "Bulls and Cows",
+			guesses: []string{"roate"},
+			scores:  []string{"0/4"},
+			scorer:  ScoreBullsAndCows,
+			want:    []string{"avert", "alert", "overt", "tabor"},
+		},
+		{
+			name:    "Bulls and Cows Narrowed Down",
+			guesses: []string{"roate", "alert"},
+			scores:  []string{"0/4", "3/0"},
+			scorer:  ScoreBullsAndCows,
+			want:    []string{"overt"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetScoredMatchingWords(words, tt.guesses, tt.scores, tt.scorer); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetScoredMatchingWords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetBestScoredGuesses(t *testing.T) {
+	candidates := []string{"avert", "overt"}
+	bestGuesses, expectedRemaining := GetBestScoredGuesses(candidates, []string{"pilly", "overt", "avert", "above"}, ScoreBullsAndCows)
+	if bestGuesses[0] != "overt" || expectedRemaining["overt"] != 1 {
+		t.Errorf("GetBestScoredGuesses() = %v %v, want 'overt' first with 1 expected remaining", bestGuesses, expectedRemaining)
+	}
+	if bestGuesses[len(bestGuesses)-1] != "pilly" || expectedRemaining["pilly"] != 2 {
+		t.Errorf("GetBestScoredGuesses() = %v %v, want 'pilly' last with 2 expected remaining", bestGuesses, expectedRemaining)
+	}
+}
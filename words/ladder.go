@@ -0,0 +1,104 @@
+package words
+
+import (
+	"sort"
+	"strings"
+)
+
+// getLadderIndex groups the words by each pattern made from replacing one letter with a wildcard, so the words that
+// are one letter apart share a pattern.
+func getLadderIndex(words []string) map[string][]string {
+	index := map[string][]string{}
+	for _, word := range words {
+		for i := 0; i < len(word); i++ {
+			pattern := word[:i] + WildcardChar + word[i+1:]
+			index[pattern] = append(index[pattern], word)
+		}
+	}
+	return index
+}
+
+func getLadderNeighbors(word string, index map[string][]string) []string {
+	neighbors := []string{}
+	for i := 0; i < len(word); i++ {
+		for _, neighbor := range index[word[:i]+WildcardChar+word[i+1:]] {
+			if neighbor != word {
+				neighbors = append(neighbors, neighbor)
+			}
+		}
+	}
+	sort.Strings(neighbors)
+	return neighbors
+}
+
+// GetLadderNeighbors finds the words that are the same length as word and have exactly one different letter.
+func GetLadderNeighbors(word string, words []string) []string {
+	return getLadderNeighbors(word, getLadderIndex(words))
+}
+
+// GetLadderPaths returns the shortest ladders from one word to another, or only the first one unless allPaths is set.
+func GetLadderPaths(words []string, from string, to string, allPaths bool) [][]string {
+	if len(from) != len(to) {
+		return nil
+	}
+	if from == to {
+		return [][]string{{from}}
+	}
+
+	ladderWords := []string{from, to}
+	for _, word := range words {
+		if len(word) == len(from) && word != from && word != to {
+			ladderWords = append(ladderWords, word)
+		}
+	}
+	index := getLadderIndex(ladderWords)
+
+	// Breadth first search, keeping every word on the level before that leads to a word so that all of the shortest
+	// paths can be rebuilt.
+	previous := map[string][]string{from: nil}
+	level := []string{from}
+	for len(level) > 0 {
+		nextPrevious := map[string][]string{}
+		nextLevel := []string{}
+		for _, word := range level {
+			for _, neighbor := range getLadderNeighbors(word, index) {
+				if _, visited := previous[neighbor]; visited {
+					continue
+				}
+				if _, found := nextPrevious[neighbor]; !found {
+					nextLevel = append(nextLevel, neighbor)
+				}
+				nextPrevious[neighbor] = append(nextPrevious[neighbor], word)
+			}
+		}
+		for word, previousWords := range nextPrevious {
+			previous[word] = previousWords
+		}
+		if _, found := nextPrevious[to]; found {
+			paths := getLadderPaths(to, previous, allPaths)
+			sort.Slice(paths, func(i, j int) bool {
+				return strings.Join(paths[i], " ") < strings.Join(paths[j], " ")
+			})
+			return paths
+		}
+		level = nextLevel
+	}
+
+	return nil
+}
+
+func getLadderPaths(word string, previous map[string][]string, allPaths bool) [][]string {
+	if len(previous[word]) == 0 {
+		return [][]string{{word}}
+	}
+	paths := [][]string{}
+	for _, previousWord := range previous[word] {
+		for _, path := range getLadderPaths(previousWord, previous, allPaths) {
+			paths = append(paths, append(path, word))
+			if !allPaths {
+				return paths
+			}
+		}
+	}
+	return paths
+}
@@ -0,0 +1,67 @@
+package words
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetLadderNeighbors(t *testing.T) {
+	words := []string{"cold", "cord", "card", "ward", "warm", "word", "worm", "bold"}
+	want := []string{"bold", "cord"}
+	if got := GetLadderNeighbors("cold", words); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetLadderNeighbors() = %v, want %v", got, want)
+	}
+}
+
+func TestGetLadderPaths(t *testing.T) {
+	words := []string{"cold", "cord", "card", "ward", "warm", "word", "worm", "bold", "wold"}
+	type args struct {
+		from     string
+		to       string
+		allPaths bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want [][]string
+	}{
+		{
+			name: "Same Word",
+			args: args{from: "cold", to: "cold", allPaths: false},
+			want: [][]string{{"cold"}},
+		},
+		{
+			name: "Different Lengths",
+			args: args{from: "cold", to: "warms", allPaths: false},
+			want: nil,
+		},
+		{
+			name: "No Path",
+			args: args{from: "cold", to: "hymn", allPaths: false},
+			want: nil,
+		},
+		{
+			name: "All Shortest Paths",
+			args: args{from: "cold", to: "warm", allPaths: true},
+			want: [][]string{
+				{"cold", "cord", "card", "ward", "warm"},
+				{"cold", "cord", "word", "ward", "warm"},
+				{"cold", "cord", "word", "worm", "warm"},
+				{"cold", "wold", "word", "ward", "warm"},
+				{"cold", "wold", "word", "worm", "warm"},
+			},
+		},
+		{
+			name: "First Shortest Path",
+			args: args{from: "cold", to: "warm", allPaths: false},
+			want: [][]string{{"cold", "cord", "card", "ward", "warm"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetLadderPaths(words, tt.args.from, tt.args.to, tt.args.allPaths); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetLadderPaths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
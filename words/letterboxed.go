@@ -0,0 +1,279 @@
+package words
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const LetterBoxSideSeparator = ","
+
+// letterBoxState is a node of the Letter Boxed graph: the letter the next word must start with and the puzzle letters
+// that have been used so far (1 bit per letter of the alphabet).
+type letterBoxState struct {
+	lastLetter byte
+	covered    uint32
+}
+
+// letterBoxEdge is a word that leads to a state from an earlier state, start is nil for the first word of a chain.
+type letterBoxEdge struct {
+	start *letterBoxState
+	word  string
+}
+
+// ParseLetterBoxSides splits the sides of a Letter Boxed puzzle such as 'abc,def,ghi,jkl'.
+func ParseLetterBoxSides(sides string) ([]string, error) {
+	letterBoxSides := strings.Split(strings.ToLower(sides), LetterBoxSideSeparator)
+	if len(letterBoxSides) < 2 {
+		return nil, fmt.Errorf("there must be at least 2 sides separated by '%s'", LetterBoxSideSeparator)
+	}
+	allLetters := ""
+	for i, side := range letterBoxSides {
+		side = strings.TrimSpace(side)
+		if len(side) == 0 {
+			return nil, fmt.Errorf("side #%d does not have any letters", i+1)
+		}
+		for _, letter := range side {
+			if !strings.ContainsRune(Alphabet, letter) {
+				return nil, fmt.Errorf("'%s' on side #%d is not a letter", string(letter), i+1)
+			}
+			if strings.ContainsRune(allLetters, letter) {
+				return nil, fmt.Errorf("'%s' is on more than one side", string(letter))
+			}
+			allLetters += string(letter)
+		}
+		letterBoxSides[i] = side
+	}
+	return letterBoxSides, nil
+}
+
+// IsLetterBoxWord reports whether word can be played without two letters from the same side in a row.
+func IsLetterBoxWord(word string, sides []string) bool {
+	lastSide := -1
+	for _, letter := range word {
+		side := -1
+		for i := range sides {
+			if strings.ContainsRune(sides[i], letter) {
+				side = i
+				break
+			}
+		}
+		if side < 0 || side == lastSide {
+			return false
+		}
+		lastSide = side
+	}
+	return len(word) > 0
+}
+
+// GetLetterBoxWords returns the words that can be played on the sides.
+func GetLetterBoxWords(words []string, sides []string) []string {
+	var letterBoxWords []string
+
+	for _, word := range words {
+		if IsLetterBoxWord(word, sides) {
+			letterBoxWords = append(letterBoxWords, word)
+		}
+	}
+
+	return letterBoxWords
+}
+
+// GetLetterBoxSolutions returns up to maxSolutions chains with the fewest words that use every letter.
+func GetLetterBoxSolutions(words []string, sides []string, maxWords int, maxSolutions int) [][]string {
+	allLetters := letterMask(strings.Join(sides, ""))
+	letterBoxWords := GetLetterBoxWords(words, sides)
+	wordsByFirstLetter := map[byte][]string{}
+	for _, word := range letterBoxWords {
+		wordsByFirstLetter[word[0]] = append(wordsByFirstLetter[word[0]], word)
+	}
+
+	// Breadth first search so that the first level to use every letter has the fewest words. Every edge into a state is
+	// kept from the level where the state was first reached, so that all of the shortest chains can be rebuilt.
+	edges := map[letterBoxState][]letterBoxEdge{}
+	level := map[letterBoxState]int{}
+	frontier := []letterBoxState{}
+	addEdge := func(state letterBoxState, edge letterBoxEdge, wordCount int) {
+		if reached, visited := level[state]; visited {
+			if reached == wordCount {
+				edges[state] = append(edges[state], edge)
+			}
+			return
+		}
+		level[state] = wordCount
+		edges[state] = []letterBoxEdge{edge}
+		frontier = append(frontier, state)
+	}
+
+	for _, word := range letterBoxWords {
+		addEdge(letterBoxState{lastLetter: word[len(word)-1], covered: letterMask(word)}, letterBoxEdge{word: word}, 1)
+	}
+
+	for wordCount := 1; wordCount <= maxWords; wordCount++ {
+		solved := []letterBoxState{}
+		for _, state := range frontier {
+			if state.covered == allLetters {
+				solved = append(solved, state)
+			}
+		}
+		if len(solved) > 0 {
+			return sortLetterBoxSolutions(getLetterBoxChains(solved, edges, maxSolutions), maxSolutions)
+		}
+		if wordCount == maxWords {
+			break
+		}
+
+		current := frontier
+		frontier = []letterBoxState{}
+		for i := range current {
+			start := current[i]
+			for _, word := range wordsByFirstLetter[start.lastLetter] {
+				// A word that does not use any new letters can still lead to the letter that the next word starts with.
+				covered := start.covered | letterMask(word)
+				addEdge(letterBoxState{lastLetter: word[len(word)-1], covered: covered}, letterBoxEdge{start: &current[i], word: word}, wordCount+1)
+			}
+		}
+	}
+
+	return nil
+}
+
+// letterBoxStep is a word that leads from one state to the next.
+type letterBoxStep struct {
+	next letterBoxState
+	word string
+}
+
+// letterBoxChain is the start of a chain of words that leads to state. letters is the length of the words so far plus
+// the fewest letters of the words that can finish the chain.
+type letterBoxChain struct {
+	state       letterBoxState
+	words       []string
+	text        string // The words separated by spaces.
+	wordLetters int
+	letters     int
+	complete    bool
+}
+
+// letterBoxQueue orders chains by the fewest letters and then alphabetically. A chain is never after the chains that
+// start with it, so the chains come out of the queue complete in the same order that they are sorted.
+type letterBoxQueue []letterBoxChain
+
+func (queue letterBoxQueue) Len() int { return len(queue) }
+
+func (queue letterBoxQueue) Less(i, j int) bool {
+	if queue[i].letters != queue[j].letters {
+		return queue[i].letters < queue[j].letters
+	}
+	if queue[i].text != queue[j].text {
+		return queue[i].text < queue[j].text
+	}
+	return queue[i].complete && !queue[j].complete
+}
+
+func (queue letterBoxQueue) Swap(i, j int) { queue[i], queue[j] = queue[j], queue[i] }
+
+func (queue *letterBoxQueue) Push(chain interface{}) { *queue = append(*queue, chain.(letterBoxChain)) }
+
+func (queue *letterBoxQueue) Pop() interface{} {
+	old := *queue
+	chain := old[len(old)-1]
+	*queue = old[:len(old)-1]
+	return chain
+}
+
+// getLetterBoxChains rebuilds the chains of words that lead to the solved states, fewest letters first. There can be
+// far too many shortest chains on a dense puzzle to build all of them, so it stops after maxSolutions chains
+// (0 for all of them) and only builds the chains that start the same as the ones returned.
+func getLetterBoxChains(solved []letterBoxState, edges map[letterBoxState][]letterBoxEdge, maxSolutions int) [][]string {
+	firstWords := []letterBoxStep{}
+	nextWords := map[letterBoxState][]letterBoxStep{}
+	for state, stateEdges := range edges {
+		for _, edge := range stateEdges {
+			if edge.start == nil {
+				firstWords = append(firstWords, letterBoxStep{next: state, word: edge.word})
+			} else {
+				nextWords[*edge.start] = append(nextWords[*edge.start], letterBoxStep{next: state, word: edge.word})
+			}
+		}
+	}
+
+	// The fewest letters to finish a chain from each state, -1 when it can't be finished.
+	fewestLetters := map[letterBoxState]int{}
+	for _, state := range solved {
+		fewestLetters[state] = 0
+	}
+	var getFewestLetters func(state letterBoxState) int
+	getFewestLetters = func(state letterBoxState) int {
+		if letters, found := fewestLetters[state]; found {
+			return letters
+		}
+		fewest := -1
+		for _, step := range nextWords[state] {
+			if letters := getFewestLetters(step.next); letters >= 0 && (fewest < 0 || len(step.word)+letters < fewest) {
+				fewest = len(step.word) + letters
+			}
+		}
+		fewestLetters[state] = fewest
+		return fewest
+	}
+
+	queue := &letterBoxQueue{}
+	addWord := func(chain letterBoxChain, step letterBoxStep) {
+		letters := getFewestLetters(step.next)
+		if letters < 0 {
+			return
+		}
+		next := letterBoxChain{
+			state:       step.next,
+			words:       append(append([]string{}, chain.words...), step.word),
+			wordLetters: chain.wordLetters + len(step.word),
+			complete:    letters == 0,
+		}
+		next.text = strings.Join(next.words, " ")
+		next.letters = next.wordLetters + letters
+		heap.Push(queue, next)
+	}
+	for _, step := range firstWords {
+		addWord(letterBoxChain{}, step)
+	}
+
+	chains := [][]string{}
+	for queue.Len() > 0 && (maxSolutions <= 0 || len(chains) < maxSolutions) {
+		chain := heap.Pop(queue).(letterBoxChain)
+		if chain.complete {
+			chains = append(chains, chain.words)
+			continue
+		}
+		for _, step := range nextWords[chain.state] {
+			addWord(chain, step)
+		}
+	}
+	return chains
+}
+
+func sortLetterBoxSolutions(solutions [][]string, maxSolutions int) [][]string {
+	sort.Slice(solutions, func(i, j int) bool {
+		lengthI := len(strings.Join(solutions[i], ""))
+		lengthJ := len(strings.Join(solutions[j], ""))
+		if lengthI != lengthJ {
+			return lengthI < lengthJ
+		}
+		return strings.Join(solutions[i], " ") < strings.Join(solutions[j], " ")
+	})
+	if maxSolutions > 0 && len(solutions) > maxSolutions {
+		solutions = solutions[:maxSolutions]
+	}
+	return solutions
+}
+
+func letterMask(letters string) uint32 {
+	var mask uint32
+	for _, letter := range letters {
+		if letter >= 'a' && letter <= 'z' {
+			mask |= 1 << uint(letter-'a')
+		}
+	}
+	return mask
+}
@@ -0,0 +1,188 @@
+package words
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseLetterBoxSides(t *testing.T) {
+	tests := []struct {
+		name    string
+		sides   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "Four Sides",
+			sides: "ABC,def, ghi,jkl",
+			want:  []string{"abc", "def", "ghi", "jkl"},
+		},
+		{
+			name:    "One Side",
+			sides:   "abcdef",
+			wantErr: true,
+		},
+		{
+			name:    "Empty Side",
+			sides:   "abc,,ghi",
+			wantErr: true,
+		},
+		{
+			name:    "Letter On Two Sides",
+			sides:   "abc,dea",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLetterBoxSides(tt.sides)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseLetterBoxSides() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseLetterBoxSides() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsLetterBoxWord(t *testing.T) {
+	sides := []string{"ab", "cd", "ef"}
+	tests := []struct {
+		name string
+		word string
+		want bool
+	}{
+		{
+			name: "Alternating Sides",
+			word: "ace",
+			want: true,
+		},
+		{
+			name: "Same Side In A Row",
+			word: "abe",
+			want: false,
+		},
+		{
+			name: "Letter Not On A Side",
+			word: "acx",
+			want: false,
+		},
+		{
+			name: "Same Side Not In A Row",
+			word: "ebfd",
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsLetterBoxWord(tt.word, sides); got != tt.want {
+				t.Errorf("IsLetterBoxWord() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetLetterBoxSolutions(t *testing.T) {
+	sides := []string{"ab", "cd", "ef"}
+	tests := []struct {
+		name         string
+		words        []string
+		maxWords     int
+		maxSolutions int
+		want         [][]string
+	}{
+		{
+			name:         "No Solution",
+			words:        []string{"ace", "ebf"},
+			maxWords:     5,
+			maxSolutions: 10,
+			want:         nil,
+		},
+		{
+			name:         "Three Words",
+			words:        []string{"ace", "ebf", "fad", "abe"},
+			maxWords:     5,
+			maxSolutions: 10,
+			want:         [][]string{{"ace", "ebf", "fad"}},
+		},
+		{
+			name:         "Word Without New Letters",
+			words:        []string{"ace", "ea", "adbf"},
+			maxWords:     5,
+			maxSolutions: 10,
+			want:         [][]string{{"ace", "ea", "adbf"}},
+		},
+		{
+			name:         "Too Many Words",
+			words:        []string{"ace", "ebf", "fad"},
+			maxWords:     2,
+			maxSolutions: 10,
+			want:         nil,
+		},
+		{
+			name:         "Single Word",
+			words:        []string{"ace", "ebf", "fad", "ebfd", "acebd", "dacebf"},
+			maxWords:     5,
+			maxSolutions: 10,
+			want:         [][]string{{"dacebf"}},
+		},
+		{
+			name:         "All Shortest Chains",
+			words:        []string{"ace", "ebf", "fad", "ebfd", "ebdf", "fbde"},
+			maxWords:     5,
+			maxSolutions: 10,
+			want:         [][]string{{"ace", "ebdf"}, {"ace", "ebfd"}},
+		},
+		{
+			name:         "Limit Solutions",
+			words:        []string{"ace", "ebf", "fad", "ebfd", "ebdf", "fbde"},
+			maxWords:     5,
+			maxSolutions: 1,
+			want:         [][]string{{"ace", "ebdf"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetLetterBoxSolutions(tt.words, sides, tt.maxWords, tt.maxSolutions); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetLetterBoxSolutions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetLetterBoxSolutionsDenseBoard(t *testing.T) {
+	// Every word of 3 or 4 letters that can be played, so there are far more shortest chains than are returned.
+	sides := []string{"ab", "cd", "ef"}
+	letterBoxWords := []string{""}
+	denseWords := []string{}
+	for length := 1; length <= 4; length++ {
+		longerWords := []string{}
+		for _, word := range letterBoxWords {
+			for _, letter := range "abcdef" {
+				if longerWord := word + string(letter); IsLetterBoxWord(longerWord, sides) {
+					longerWords = append(longerWords, longerWord)
+					if length >= 3 {
+						denseWords = append(denseWords, longerWord)
+					}
+				}
+			}
+		}
+		letterBoxWords = longerWords
+	}
+
+	allSolutions := GetLetterBoxSolutions(denseWords, sides, 5, 0)
+	if len(allSolutions) <= 100 {
+		t.Fatalf("GetLetterBoxSolutions() found %d chains, want a board with more than 100", len(allSolutions))
+	}
+	got := GetLetterBoxSolutions(denseWords, sides, 5, 3)
+	if !reflect.DeepEqual(got, allSolutions[:3]) {
+		t.Errorf("GetLetterBoxSolutions() = %v, want %v", got, allSolutions[:3])
+	}
+	for _, chain := range got {
+		if len(chain) != 2 || len(chain[0])+len(chain[1]) != 7 {
+			t.Errorf("GetLetterBoxSolutions() chain %v is not one of the shortest", chain)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package words
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	LingoMinWordLength = 5
+	LingoMaxWordLength = 7
+)
+
+// RevealLetter puts a revealed letter into the pattern at position, which starts at 1.
+func RevealLetter(pattern string, position int, letter string) (string, error) {
+	if position < 1 || position > len(pattern) {
+		return pattern, fmt.Errorf("position #%d is not in the %d letter pattern", position, len(pattern))
+	}
+	if len(letter) != 1 || !strings.Contains(Alphabet, letter) {
+		return pattern, errors.New("'" + letter + "' is not a single letter")
+	}
+	revealed := pattern[position-1 : position]
+	if revealed != WildcardChar && revealed != letter {
+		return pattern, fmt.Errorf("'%s' is already revealed in position #%d", revealed, position)
+	}
+	return pattern[:position-1] + letter + pattern[position:], nil
+}
+
+// GetLingoGuessWords returns the words that begin with the revealed first letter.
+func GetLingoGuessWords(words []string, firstLetter string) []string {
+	guessWords := []string{}
+	for _, word := range words {
+		if strings.HasPrefix(word, firstLetter) {
+			guessWords = append(guessWords, word)
+		}
+	}
+	return guessWords
+}
@@ -0,0 +1,76 @@
+package words
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRevealLetter(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		position int
+		letter   string
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "First Letter",
+			pattern:  "-----",
+			position: 1,
+			letter:   "t",
+			want:     "t----",
+		},
+		{
+			name:     "Already Revealed",
+			pattern:  "t---t",
+			position: 1,
+			letter:   "t",
+			want:     "t---t",
+		},
+		{
+			name:     "Different Letter Revealed",
+			pattern:  "a----",
+			position: 1,
+			letter:   "t",
+			want:     "a----",
+			wantErr:  true,
+		},
+		{
+			name:     "Position Out Of Range",
+			pattern:  "-----",
+			position: 6,
+			letter:   "t",
+			want:     "-----",
+			wantErr:  true,
+		},
+		{
+			name:     "Not A Letter",
+			pattern:  "-----",
+			position: 1,
+			letter:   "1",
+			want:     "-----",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RevealLetter(tt.pattern, tt.position, tt.letter)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RevealLetter() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("RevealLetter() = '%v', want '%v'", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetLingoGuessWords(t *testing.T) {
+	words := []string{"tabor", "avert", "tarot", "start"}
+	want := []string{"tabor", "tarot"}
+	if got := GetLingoGuessWords(words, "t"); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetLingoGuessWords() = %v, want %v", got, want)
+	}
+}
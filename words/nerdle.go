@@ -0,0 +1,120 @@
+package words
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	NerdleLength     = 8
+	NerdleMinLength  = 5
+	NerdleMaxLength  = 8
+	NerdleDigits     = "0123456789"
+	NerdleOperators  = "+-*/"
+	NerdleEqualsChar = "="
+)
+
+// nerdleSymbols are encoded as the letters at the same index in the Alphabet, so the pattern and exclusion
+// constraints can be used without the '-' operator being mistaken for a WildcardChar.
+const nerdleSymbols = NerdleDigits + NerdleOperators + NerdleEqualsChar
+
+// GetNerdleEquations returns every valid Nerdle equation of the length, such as '12+35=47'.
+func GetNerdleEquations(length int, operators string) []string {
+	// The left side needs an operator and uses the usual order of operations. The right side is a whole number that is
+	// not negative, although the steps to get there can be fractions. Numbers can't have leading zeros and a zero can
+	// only be on the right side.
+	equations := []string{}
+	for leftLength := 3; leftLength <= length-2; leftLength++ {
+		rightLength := length - leftLength - 1
+		addNerdleEquations(&equations, make([]byte, 0, leftLength), leftLength, rightLength, operators, fraction{0, 1}, fraction{0, 1}, '+', false)
+	}
+	sort.Strings(equations)
+	return equations
+}
+
+// fraction is a value on the left side of a Nerdle equation, which can have a remainder after a division.
+type fraction struct {
+	num int
+	den int
+}
+
+func (f fraction) add(other fraction) fraction {
+	return newFraction(f.num*other.den+other.num*f.den, f.den*other.den)
+}
+
+func (f fraction) mul(num int, den int) fraction {
+	return newFraction(f.num*num, f.den*den)
+}
+
+func newFraction(num int, den int) fraction {
+	a, b := num, den
+	if a < 0 {
+		a = -a
+	}
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return fraction{num / a, den / a}
+}
+
+// addNerdleEquations adds a number to the left side of the equation, then either finishes the equation or adds an
+// operator and another number. sum is the total of the terms before the current term.
+func addNerdleEquations(equations *[]string, left []byte, leftLength int, rightLength int, operators string, sum fraction, term fraction, operator byte, hasOperator bool) {
+	for digits := 1; digits <= leftLength-len(left); digits++ {
+		first, last := 1, 9
+		for i := 1; i < digits; i++ {
+			first, last = first*10, last*10+9
+		}
+		for number := first; number <= last; number++ {
+			nextSum, nextTerm := sum, term
+			switch operator {
+			case '+':
+				nextSum, nextTerm = sum.add(term), fraction{number, 1}
+			case '-':
+				nextSum, nextTerm = sum.add(term), fraction{-number, 1}
+			case '*':
+				nextTerm = term.mul(number, 1)
+			case '/':
+				nextTerm = term.mul(1, number)
+			}
+			nextLeft := strconv.AppendInt(left, int64(number), 10)
+			if len(nextLeft) == leftLength {
+				if value := nextSum.add(nextTerm); hasOperator && value.den == 1 && value.num >= 0 {
+					if right := strconv.Itoa(value.num); len(right) == rightLength {
+						*equations = append(*equations, string(nextLeft)+NerdleEqualsChar+right)
+					}
+				}
+				continue
+			}
+			if leftLength-len(nextLeft) < 2 {
+				continue
+			}
+			for i := 0; i < len(operators); i++ {
+				addNerdleEquations(equations, append(nextLeft, operators[i]), leftLength, rightLength, operators, nextSum, nextTerm, operators[i], true)
+			}
+		}
+	}
+}
+
+// EncodeNerdle replaces each symbol of the equation with a letter so that it can be matched like a word.
+func EncodeNerdle(equation string) string {
+	encoded := []byte(equation)
+	for i := range encoded {
+		if index := strings.IndexByte(nerdleSymbols, encoded[i]); index >= 0 {
+			encoded[i] = Alphabet[index]
+		}
+	}
+	return string(encoded)
+}
+
+// DecodeNerdle replaces each letter of an encoded equation with its symbol.
+func DecodeNerdle(word string) string {
+	decoded := []byte(word)
+	for i := range decoded {
+		if index := strings.IndexByte(Alphabet[:len(nerdleSymbols)], decoded[i]); index >= 0 {
+			decoded[i] = nerdleSymbols[index]
+		}
+	}
+	return string(decoded)
+}
@@ -0,0 +1,73 @@
+package words
+
+import (
+	"testing"
+)
+
+func TestGetNerdleEquations(t *testing.T) {
+	tests := []struct {
+		name      string
+		length    int
+		operators string
+		want      []string
+		wantNot   []string
+		wantCount int
+	}{
+		{
+			name:      "Addition",
+			length:    5,
+			operators: "+",
+			want:      []string{"1+2=3", "4+5=9"},
+			wantNot:   []string{"0+1=1", "5+5=10", "1-1=0", "01+2=3"},
+		},
+		{
+			name:      "Classic Nerdle",
+			length:    NerdleLength,
+			operators: NerdleOperators,
+			wantCount: 17723,
+		},
+		{
+			name:      "Order Of Operations",
+			length:    8,
+			operators: NerdleOperators,
+			want:      []string{"12+35=47", "3+4*5=23", "27/9*3=9", "10-2*3=4", "9/2*4=18", "12-3*4=0"},
+			wantNot:   []string{"17/2+1=9", "0*12+3=3", "2-9=-7", "123=123", "1+2*3=9"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetNerdleEquations(tt.length, tt.operators)
+			if tt.wantCount > 0 && len(got) != tt.wantCount {
+				t.Errorf("GetNerdleEquations() = %d equations, want %d", len(got), tt.wantCount)
+			}
+			equations := map[string]bool{}
+			for _, equation := range got {
+				if len(equation) != tt.length {
+					t.Errorf("GetNerdleEquations() '%s' is not %d long", equation, tt.length)
+				}
+				equations[equation] = true
+			}
+			for _, equation := range tt.want {
+				if !equations[equation] {
+					t.Errorf("GetNerdleEquations() is missing '%s'", equation)
+				}
+			}
+			for _, equation := range tt.wantNot {
+				if equations[equation] {
+					t.Errorf("GetNerdleEquations() includes '%s'", equation)
+				}
+			}
+		})
+	}
+}
+
+func TestEncodeNerdle(t *testing.T) {
+	equation := "10-2*3=4"
+	encoded := EncodeNerdle(equation)
+	if encoded != "balcmdoe" {
+		t.Errorf("EncodeNerdle() = '%v', want 'balcmdoe'", encoded)
+	}
+	if got := DecodeNerdle(encoded); got != equation {
+		t.Errorf("DecodeNerdle() = '%v', want '%v'", got, equation)
+	}
+}
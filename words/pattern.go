@@ -0,0 +1,157 @@
+package words
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const (
+	Alphabet       = "abcdefghijklmnopqrstuvwxyz"
+	Vowels         = "aeiou"
+	VowelClass     = "V"
+	ConsonantClass = "C"
+	AnyLettersChar = "*"
+)
+
+// NormalizePattern lowercases a pattern unless 'V' and 'C' are its only capital letters.
+func NormalizePattern(pattern string) string {
+	for _, char := range pattern {
+		if unicode.IsUpper(char) && string(char) != VowelClass && string(char) != ConsonantClass {
+			return strings.ToLower(pattern)
+		}
+	}
+	return pattern
+}
+
+// ParsePatternClasses translates the character classes in a pattern into wildcards and letters excluded by position.
+func ParsePatternClasses(pattern string) (string, map[int]string, error) {
+	wordPattern := ""
+	excludedByPosMap := map[int]string{}
+
+	for i := 0; i < len(pattern); i++ {
+		position := len(wordPattern) + 1
+		char := string(pattern[i])
+		allowedLetters := ""
+		switch char {
+		case VowelClass:
+			allowedLetters = Vowels
+		case ConsonantClass:
+			allowedLetters = exceptLetters(Vowels)
+		case "[":
+			end := strings.Index(pattern[i:], "]")
+			if end < 0 {
+				return "", nil, fmt.Errorf("missing ']' for the character class in position #%d", position)
+			}
+			var err error
+			allowedLetters, err = getClassLetters(strings.ToLower(pattern[i+1 : i+end]))
+			if err != nil {
+				return "", nil, err
+			}
+			i += end
+			if len(allowedLetters) == 0 {
+				return "", nil, fmt.Errorf("the character class in position #%d does not allow any letters", position)
+			}
+		default:
+			wordPattern += strings.ToLower(char)
+			continue
+		}
+
+		wordPattern += WildcardChar
+		if excludedLetters := exceptLetters(allowedLetters); len(excludedLetters) > 0 {
+			excludedByPosMap[position] = excludedLetters
+		}
+	}
+
+	return wordPattern, excludedByPosMap, nil
+}
+
+// PatternToRegex translates a pattern, where '*' is any number of letters, into a regular expression.
+func PatternToRegex(pattern string) (string, error) {
+	wordRegex := ""
+
+	for i := 0; i < len(pattern); i++ {
+		char := string(pattern[i])
+		switch char {
+		case AnyLettersChar:
+			wordRegex += "[a-z]*"
+		case WildcardChar:
+			wordRegex += "[a-z]"
+		case VowelClass:
+			wordRegex += "[" + Vowels + "]"
+		case ConsonantClass:
+			wordRegex += "[" + exceptLetters(Vowels) + "]"
+		case "[":
+			end := strings.Index(pattern[i:], "]")
+			if end < 0 {
+				return "", fmt.Errorf("missing ']' for the character class starting at '%s'", pattern[i:])
+			}
+			class := strings.ToLower(pattern[i+1 : i+end])
+			i += end
+			allowedLetters, err := getClassLetters(class)
+			if err != nil {
+				return "", err
+			}
+			if len(allowedLetters) == 0 {
+				return "", fmt.Errorf("the character class '[%s]' does not allow any letters", class)
+			}
+			wordRegex += "[" + allowedLetters + "]"
+		default:
+			wordRegex += regexp.QuoteMeta(strings.ToLower(char))
+		}
+	}
+
+	return "^" + wordRegex + "$", nil
+}
+
+// getClassLetters returns the letters allowed by a character class such as 'aeiou', 'a-f' or '^st'.
+func getClassLetters(class string) (string, error) {
+	letters := ""
+	negated := strings.HasPrefix(class, "^")
+	if negated {
+		class = class[1:]
+	}
+	for i := 0; i < len(class); i++ {
+		first, last := class[i], class[i]
+		if i+2 < len(class) && class[i+1] == '-' {
+			last = class[i+2]
+			if first > last {
+				return "", fmt.Errorf("the range '%s' in the character class '[%s]' is out of order", class[i:i+3], class)
+			}
+			i += 2
+		}
+		for letter := first; letter <= last; letter++ {
+			if strings.IndexByte(Alphabet, letter) >= 0 && strings.IndexByte(letters, letter) < 0 {
+				letters += string(letter)
+			}
+		}
+	}
+	if negated {
+		return exceptLetters(letters), nil
+	}
+	return letters, nil
+}
+
+func exceptLetters(letters string) string {
+	except := ""
+	for _, letter := range Alphabet {
+		if !strings.ContainsRune(letters, letter) {
+			except += string(letter)
+		}
+	}
+	return except
+}
+
+// GetRegexMatchingWords returns the words that match the regular expression.
+func GetRegexMatchingWords(words []string, wordRegex *regexp.Regexp) []string {
+	var matchingWords []string
+
+	for _, word := range words {
+		if wordRegex.MatchString(word) {
+			matchingWords = append(matchingWords, word)
+		}
+	}
+
+	return matchingWords
+}
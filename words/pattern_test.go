@@ -0,0 +1,246 @@
+package words
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func TestParsePatternClasses(t *testing.T) {
+	tests := []struct {
+		name                 string
+		pattern              string
+		wantWordPattern      string
+		wantExcludedByPosMap map[int]string
+		wantErr              bool
+	}{
+		{
+			name:                 "Letters and Wildcards",
+			pattern:              "T----",
+			wantWordPattern:      "t----",
+			wantExcludedByPosMap: map[int]string{},
+		},
+		{
+			name:                 "Character Class",
+			pattern:              "t[aeiou]---",
+			wantWordPattern:      "t----",
+			wantExcludedByPosMap: map[int]string{2: "bcdfghjklmnpqrstvwxyz"},
+		},
+		{
+			name:                 "Negated Character Class",
+			pattern:              "--[^st]--",
+			wantWordPattern:      "-----",
+			wantExcludedByPosMap: map[int]string{3: "st"},
+		},
+		{
+			name:                 "Vowel and Consonant Shorthand",
+			pattern:              "CV-c-",
+			wantWordPattern:      "---c-",
+			wantExcludedByPosMap: map[int]string{1: "aeiou", 2: "bcdfghjklmnpqrstvwxyz"},
+		},
+		{
+			name:                 "Character Class Range",
+			pattern:              "[a-c]--[^d-z]-",
+			wantWordPattern:      "-----",
+			wantExcludedByPosMap: map[int]string{1: "defghijklmnopqrstuvwxyz", 4: "defghijklmnopqrstuvwxyz"},
+		},
+		{
+			name:    "Character Class Range Out of Order",
+			pattern: "[c-a]----",
+			wantErr: true,
+		},
+		{
+			name:    "Missing Closing Bracket",
+			pattern: "t[ae---",
+			wantErr: true,
+		},
+		{
+			name:    "Class Without Letters",
+			pattern: "t[]---",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotWordPattern, gotExcludedByPosMap, err := ParsePatternClasses(tt.pattern)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParsePatternClasses() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if gotWordPattern != tt.wantWordPattern {
+				t.Errorf("ParsePatternClasses() gotWordPattern = %v, want %v", gotWordPattern, tt.wantWordPattern)
+			}
+			if !reflect.DeepEqual(gotExcludedByPosMap, tt.wantExcludedByPosMap) {
+				t.Errorf("ParsePatternClasses() gotExcludedByPosMap = %v, want %v", gotExcludedByPosMap, tt.wantExcludedByPosMap)
+			}
+		})
+	}
+}
+
+func TestNormalizePattern(t *testing.T) {
+	words := []string{"crane", "caper", "civic"}
+	tests := []struct {
+		name    string
+		pattern string
+		want    []string
+	}{
+		{
+			name:    "Capital Word",
+			pattern: "CRANE",
+			want:    []string{"crane"},
+		},
+		{
+			name:    "Capital Letters and Wildcards",
+			pattern: "C-A--",
+			want:    []string{"crane"},
+		},
+		{
+			name:    "Vowel and Consonant Shorthand",
+			pattern: "CVCVC",
+			want:    []string{"caper", "civic"},
+		},
+		{
+			name:    "Shorthand and Letters",
+			pattern: "cV-V-",
+			want:    []string{"caper", "civic"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wordPattern, excludedByPosMap, err := ParsePatternClasses(NormalizePattern(tt.pattern))
+			if err != nil {
+				t.Fatalf("ParsePatternClasses() error = %v", err)
+			}
+			if got := GetMatchingWords(words, wordPattern, "", "", true, excludedByPosMap); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NormalizePattern() = %v matched %v, want %v", NormalizePattern(tt.pattern), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPatternClassRange(t *testing.T) {
+	// A range such as '[a-c]' must match the same words whether the pattern is a fixed length or a regular expression.
+	words := []string{"avert", "bacon", "cabin", "tabor", "tarot"}
+	tests := []struct {
+		name    string
+		pattern string
+		want    []string
+	}{
+		{
+			name:    "Range",
+			pattern: "[a-c]----",
+			want:    []string{"avert", "bacon", "cabin"},
+		},
+		{
+			name:    "Negated Range",
+			pattern: "-[^a-c]---",
+			want:    []string{"avert"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wordPattern, excludedByPosMap, err := ParsePatternClasses(tt.pattern)
+			if err != nil {
+				t.Fatalf("ParsePatternClasses() error = %v", err)
+			}
+			if got := GetMatchingWords(words, wordPattern, "", "", false, excludedByPosMap); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParsePatternClasses() matched %v, want %v", got, tt.want)
+			}
+			wordRegex, err := PatternToRegex(tt.pattern)
+			if err != nil {
+				t.Fatalf("PatternToRegex() error = %v", err)
+			}
+			if got := GetRegexMatchingWords(words, regexp.MustCompile(wordRegex)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PatternToRegex() = %v matched %v, want %v", wordRegex, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPatternToRegex(t *testing.T) {
+	words := []string{"tabor", "tar", "tarot", "starter", "outran", "avert"}
+	tests := []struct {
+		name    string
+		pattern string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "Starts With",
+			pattern: "t*",
+			want:    []string{"tabor", "tar", "tarot"},
+		},
+		{
+			name:    "Ends With",
+			pattern: "*t",
+			want:    []string{"tarot", "avert"},
+		},
+		{
+			name:    "Contains",
+			pattern: "*ar*",
+			want:    []string{"tar", "tarot", "starter"},
+		},
+		{
+			name:    "Wildcards and Classes",
+			pattern: "V-[^a]r*",
+			want:    []string{"outran", "avert"},
+		},
+		{
+			name:    "Fixed Length",
+			pattern: "t----",
+			want:    []string{"tabor", "tarot"},
+		},
+		{
+			name:    "Missing Closing Bracket",
+			pattern: "*[ae",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PatternToRegex(tt.pattern)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PatternToRegex() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if matched := GetRegexMatchingWords(words, regexp.MustCompile(got)); !reflect.DeepEqual(matched, tt.want) {
+				t.Errorf("PatternToRegex() = %v matched %v, want %v", got, matched, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetRegexMatchingWords(t *testing.T) {
+	tests := []struct {
+		name      string
+		words     []string
+		wordRegex string
+		want      []string
+	}{
+		{
+			name:      "No Matches",
+			words:     []string{"tabor", "talar", "tardo"},
+			wordRegex: "^x",
+			want:      nil,
+		},
+		{
+			name:      "Some Matches",
+			words:     []string{"tabor", "talar", "tardo", "tardy"},
+			wordRegex: "^ta(r|l)",
+			want:      []string{"talar", "tardo", "tardy"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetRegexMatchingWords(tt.words, regexp.MustCompile(tt.wordRegex)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetRegexMatchingWords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
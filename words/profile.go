@@ -0,0 +1,42 @@
+package words
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// ParseProfiles reads the named profiles of flag values from a JSON config such as '{"csw6": {"length": 6}}'.
+func ParseProfiles(config []byte) (map[string]map[string]string, error) {
+	var rawProfiles map[string]map[string]interface{}
+	if err := json.Unmarshal(config, &rawProfiles); err != nil {
+		return nil, err
+	}
+
+	profiles := map[string]map[string]string{}
+	for name, rawFlags := range rawProfiles {
+		flags := map[string]string{}
+		for flag, rawValue := range rawFlags {
+			switch value := rawValue.(type) {
+			case string:
+				flags[flag] = value
+			case float64, bool:
+				flags[flag] = fmt.Sprint(value)
+			default:
+				return nil, fmt.Errorf("profile '%s' flag '%s' must be a string, number, or true or false", name, flag)
+			}
+		}
+		profiles[name] = flags
+	}
+	return profiles, nil
+}
+
+// GetProfileNames returns the names of the profiles in order.
+func GetProfileNames(profiles map[string]map[string]string) []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
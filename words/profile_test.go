@@ -0,0 +1,54 @@
+package words
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseProfiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		want    map[string]map[string]string
+		wantErr bool
+	}{
+		{
+			name:   "Flag Values",
+			config: `{"csw6": {"file": "CSW21.txt", "length": 6, "max-print": 300, "stats": true}, "wordle": {}}`,
+			want: map[string]map[string]string{
+				"csw6":   {"file": "CSW21.txt", "length": "6", "max-print": "300", "stats": "true"},
+				"wordle": {},
+			},
+		},
+		{
+			name:    "Value Is A List",
+			config:  `{"csw6": {"file": ["CSW21.txt"]}}`,
+			wantErr: true,
+		},
+		{
+			name:    "Invalid JSON",
+			config:  `{"csw6": `,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseProfiles([]byte(tt.config))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseProfiles() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseProfiles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetProfileNames(t *testing.T) {
+	profiles := map[string]map[string]string{"wordle": {}, "csw6": {}, "lingo": {}}
+	want := []string{"csw6", "lingo", "wordle"}
+	if got := GetProfileNames(profiles); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetProfileNames() = %v, want %v", got, want)
+	}
+}
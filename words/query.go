@@ -0,0 +1,225 @@
+package words
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Matcher reports whether a word satisfies a compiled query.
+type Matcher func(word string) bool
+
+const (
+	QueryAnd   = "&"
+	QueryOr    = "|"
+	QueryNot   = "!"
+	QueryOpen  = "("
+	QueryClose = ")"
+)
+
+type queryParser struct {
+	tokens []string
+	next   int
+}
+
+// ParseQuery compiles a query of terms such as 'has:e' combined with '&', '|', '!' and parentheses.
+func ParseQuery(query string) (Matcher, error) {
+	parser := &queryParser{tokens: tokenizeQuery(query)}
+	if len(parser.tokens) == 0 {
+		return nil, fmt.Errorf("query is empty")
+	}
+	matcher, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if parser.next < len(parser.tokens) {
+		return nil, fmt.Errorf("unexpected '%s'", parser.tokens[parser.next])
+	}
+	return matcher, nil
+}
+
+// GetQueryMatchingWords returns the words that match the query.
+func GetQueryMatchingWords(words []string, matcher Matcher) []string {
+	var matchingWords []string
+
+	for _, word := range words {
+		if matcher(word) {
+			matchingWords = append(matchingWords, word)
+		}
+	}
+
+	return matchingWords
+}
+
+func tokenizeQuery(query string) []string {
+	tokens := []string{}
+	term := ""
+	for _, char := range strings.ToLower(query) {
+		switch {
+		case strings.ContainsRune(QueryAnd+QueryOr+QueryOpen+QueryClose, char),
+			string(char) == QueryNot && len(term) == 0:
+			if len(term) > 0 {
+				tokens = append(tokens, term)
+				term = ""
+			}
+			tokens = append(tokens, string(char))
+		case unicode.IsSpace(char):
+			if len(term) > 0 {
+				tokens = append(tokens, term)
+				term = ""
+			}
+		default:
+			term += string(char)
+		}
+	}
+	if len(term) > 0 {
+		tokens = append(tokens, term)
+	}
+	return tokens
+}
+
+func (parser *queryParser) peek() string {
+	if parser.next < len(parser.tokens) {
+		return parser.tokens[parser.next]
+	}
+	return ""
+}
+
+func (parser *queryParser) parseOr() (Matcher, error) {
+	left, err := parser.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for parser.peek() == QueryOr {
+		parser.next++
+		right, err := parser.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		either := left
+		left = func(word string) bool { return either(word) || right(word) }
+	}
+	return left, nil
+}
+
+func (parser *queryParser) parseAnd() (Matcher, error) {
+	left, err := parser.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for parser.peek() == QueryAnd {
+		parser.next++
+		right, err := parser.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		both := left
+		left = func(word string) bool { return both(word) && right(word) }
+	}
+	return left, nil
+}
+
+func (parser *queryParser) parseNot() (Matcher, error) {
+	if parser.peek() == QueryNot {
+		parser.next++
+		matcher, err := parser.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return func(word string) bool { return !matcher(word) }, nil
+	}
+	return parser.parseTerm()
+}
+
+func (parser *queryParser) parseTerm() (Matcher, error) {
+	token := parser.peek()
+	parser.next++
+	switch token {
+	case "":
+		return nil, fmt.Errorf("query ends unexpectedly")
+	case QueryOpen:
+		matcher, err := parser.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if parser.peek() != QueryClose {
+			return nil, fmt.Errorf("missing '%s'", QueryClose)
+		}
+		parser.next++
+		return matcher, nil
+	case QueryAnd, QueryOr, QueryClose:
+		return nil, fmt.Errorf("unexpected '%s'", token)
+	}
+	return parseQueryTerm(token)
+}
+
+func parseQueryTerm(term string) (Matcher, error) {
+	parts := strings.SplitN(term, ":", 2)
+	if len(parts) != 2 || len(parts[1]) == 0 {
+		return nil, fmt.Errorf("'%s' must be in the form name:value", term)
+	}
+	name, value := parts[0], parts[1]
+
+	switch {
+	case name == "has":
+		return func(word string) bool {
+			for _, letter := range value {
+				if !strings.ContainsRune(word, letter) {
+					return false
+				}
+			}
+			return true
+		}, nil
+	case name == "starts":
+		return func(word string) bool { return strings.HasPrefix(word, value) }, nil
+	case name == "ends":
+		return func(word string) bool { return strings.HasSuffix(word, value) }, nil
+	case name == "contains":
+		return func(word string) bool { return strings.Contains(word, value) }, nil
+	case name == "count":
+		return parseCountTerm(term, value)
+	case strings.HasPrefix(name, "pos"):
+		position, err := strconv.Atoi(strings.TrimPrefix(name, "pos"))
+		if err != nil || position < 1 {
+			return nil, fmt.Errorf("'%s' must have a position greater than or equal to 1", term)
+		}
+		return func(word string) bool {
+			return position <= len(word) && strings.ContainsRune(value, rune(word[position-1]))
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown term '%s'", term)
+}
+
+func parseCountTerm(term string, value string) (Matcher, error) {
+	// Check the two character operators first so '<=' is not read as '<'.
+	for _, op := range []string{"!=", "<=", ">=", "=", "<", ">"} {
+		index := strings.Index(value, op)
+		if index != 1 {
+			continue
+		}
+		letter := value[:1]
+		count, err := strconv.Atoi(value[index+len(op):])
+		if err != nil {
+			return nil, fmt.Errorf("'%s' must end with a number", term)
+		}
+		return func(word string) bool {
+			letterCount := strings.Count(word, letter)
+			switch op {
+			case "!=":
+				return letterCount != count
+			case "<=":
+				return letterCount <= count
+			case ">=":
+				return letterCount >= count
+			case "<":
+				return letterCount < count
+			case ">":
+				return letterCount > count
+			default:
+				return letterCount == count
+			}
+		}, nil
+	}
+	return nil, fmt.Errorf("'%s' must be in the form count:<letter><op><number>", term)
+}
@@ -0,0 +1,92 @@
+package words
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseQuery(t *testing.T) {
+	words := []string{"eerie", "leery", "rebel", "tarot", "treat", "where"}
+	tests := []struct {
+		name    string
+		query   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "Has Letter",
+			query: "has:a",
+			want:  []string{"tarot", "treat"},
+		},
+		{
+			name:  "And, Or and Grouping",
+			query: "has:e & (has:l | has:h)",
+			want:  []string{"leery", "rebel", "where"},
+		},
+		{
+			name:  "Not Position",
+			query: "has:r & !pos1:tw",
+			want:  []string{"eerie", "leery", "rebel"},
+		},
+		{
+			name:  "Letter Count",
+			query: "count:e=2 | count:e>=3",
+			want:  []string{"eerie", "leery", "rebel", "where"},
+		},
+		{
+			name:  "Letter Count Not Equal",
+			query: "has:e & count:e!=2",
+			want:  []string{"eerie", "treat"},
+		},
+		{
+			name:  "Starts, Ends and Contains",
+			query: "starts:t & ends:t & contains:ro",
+			want:  []string{"tarot"},
+		},
+		{
+			name:  "Or Has Lower Precedence Than And",
+			query: "has:w | has:b & has:l",
+			want:  []string{"rebel", "where"},
+		},
+		{
+			name:    "Unknown Term",
+			query:   "vowels:2",
+			wantErr: true,
+		},
+		{
+			name:    "Missing Closing Parenthesis",
+			query:   "(has:e | has:a",
+			wantErr: true,
+		},
+		{
+			name:    "Dangling Operator",
+			query:   "has:e &",
+			wantErr: true,
+		},
+		{
+			name:    "Bad Count",
+			query:   "count:e~2",
+			wantErr: true,
+		},
+		{
+			name:    "Empty",
+			query:   " ",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher, err := ParseQuery(tt.query)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := GetQueryMatchingWords(words, matcher); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseQuery() matched %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
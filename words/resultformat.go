@@ -0,0 +1,55 @@
+package words
+
+import (
+	"errors"
+	"strings"
+)
+
+// ResultFormat is a set of characters to enter and show results with, in place of MatchedChar, WildcardChar and
+// MissedChar.
+type ResultFormat struct {
+	Matched  string
+	Wildcard string
+	Missed   string
+}
+
+// ResultFormats are the supported result characters, named by the characters for matched, wildcard and missed.
+var ResultFormats = map[string]ResultFormat{
+	MatchedChar + WildcardChar + MissedChar: {Matched: MatchedChar, Wildcard: WildcardChar, Missed: MissedChar},
+	"gyb":                                   {Matched: "g", Wildcard: "y", Missed: "b"},
+	"210":                                   {Matched: "2", Wildcard: "1", Missed: "0"},
+	"gy.":                                   {Matched: "g", Wildcard: "y", Missed: "."},
+}
+
+// DefaultResultFormat is the name of the ResultFormat that uses MatchedChar, WildcardChar and MissedChar.
+const DefaultResultFormat = MatchedChar + WildcardChar + MissedChar
+
+// GetResultFormat finds the ResultFormat by name, where letters can be any case such as 'GYB'.
+func GetResultFormat(name string) (ResultFormat, error) {
+	if resultFormat, found := ResultFormats[strings.ToLower(name)]; found {
+		return resultFormat, nil
+	}
+	return ResultFormat{}, errors.New("'" + name + "' must be one of " + strings.Join(GetResultFormatNames(), ", "))
+}
+
+// GetResultFormatNames returns the names of the ResultFormats with the default first.
+func GetResultFormatNames() []string {
+	return []string{DefaultResultFormat, "gyb", "210", "gy."}
+}
+
+// Name returns the characters for matched, wildcard and missed.
+func (f ResultFormat) Name() string {
+	return f.Matched + f.Wildcard + f.Missed
+}
+
+// Normalize translates a result entered in this format into the default result characters.
+func (f ResultFormat) Normalize(result string) string {
+	replacer := strings.NewReplacer(f.Matched, MatchedChar, f.Wildcard, WildcardChar, f.Missed, MissedChar)
+	return replacer.Replace(strings.ToLower(result))
+}
+
+// Format translates a result from MatchedChar, WildcardChar and MissedChar into this format.
+func (f ResultFormat) Format(result string) string {
+	replacer := strings.NewReplacer(MatchedChar, f.Matched, WildcardChar, f.Wildcard, MissedChar, f.Missed)
+	return replacer.Replace(result)
+}
@@ -0,0 +1,70 @@
+package words
+
+import (
+	"testing"
+)
+
+func TestResultFormat(t *testing.T) {
+	tests := []struct {
+		name       string
+		formatName string
+		result     string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "Default",
+			formatName: DefaultResultFormat,
+			result:     "-x?=x",
+			want:       "-x?=x",
+		},
+		{
+			name:       "Uppercase Colors",
+			formatName: "GYB",
+			result:     "YB?GB",
+			want:       "-x?=x",
+		},
+		{
+			name:       "Numbers",
+			formatName: "210",
+			result:     "10?20",
+			want:       "-x?=x",
+		},
+		{
+			name:       "Dots",
+			formatName: "gy.",
+			result:     "y.?g.",
+			want:       "-x?=x",
+		},
+		{
+			name:       "Default Characters In Another Format",
+			formatName: "210",
+			result:     "-x?=0",
+			want:       "-x?=x",
+		},
+		{
+			name:       "Unknown Format",
+			formatName: "rgb",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resultFormat, err := GetResultFormat(tt.formatName)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetResultFormat() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			got := resultFormat.Normalize(tt.result)
+			if got != tt.want {
+				t.Errorf("Normalize() = '%v', want '%v'", got, tt.want)
+			}
+			if formatted := resultFormat.Format(got); resultFormat.Normalize(formatted) != got {
+				t.Errorf("Format() = '%v', does not normalize back to '%v'", formatted, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package words
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	WaffleSize     = 5
+	WaffleTiles    = 21
+	WaffleMaxSwaps = 15
+	WaffleHoleChar = " "
+)
+
+// WaffleSwap swaps the tiles at two indexes of a 5x5 Waffle grid (row * WaffleSize + column).
+type WaffleSwap struct {
+	From int
+	To   int
+}
+
+// IsWaffleTile reports whether the index of the 5x5 grid is a tile rather than a hole.
+func IsWaffleTile(index int) bool {
+	return (index/WaffleSize)%2 == 0 || (index%WaffleSize)%2 == 0
+}
+
+// GetWaffleSlots returns the grid indexes of each of the six words: the rows first and then the columns.
+func GetWaffleSlots() [][]int {
+	slots := [][]int{}
+	for row := 0; row < WaffleSize; row += 2 {
+		slot := []int{}
+		for column := 0; column < WaffleSize; column++ {
+			slot = append(slot, row*WaffleSize+column)
+		}
+		slots = append(slots, slot)
+	}
+	for column := 0; column < WaffleSize; column += 2 {
+		slot := []int{}
+		for row := 0; row < WaffleSize; row++ {
+			slot = append(slot, row*WaffleSize+column)
+		}
+		slots = append(slots, slot)
+	}
+	return slots
+}
+
+// ParseWaffle expands the 21 tile letters and results, listed row by row, into 5x5 grids with holes.
+func ParseWaffle(letters string, results string) (string, string, error) {
+	letters = strings.ToLower(letters)
+	if len(letters) != WaffleTiles {
+		return "", "", fmt.Errorf("there must be %d letters. '%s' is %d letters", WaffleTiles, letters, len(letters))
+	}
+	if len(results) != WaffleTiles {
+		return "", "", fmt.Errorf("there must be %d results. '%s' is %d results", WaffleTiles, results, len(results))
+	}
+
+	grid := ""
+	resultGrid := ""
+	tile := 0
+	for index := 0; index < WaffleSize*WaffleSize; index++ {
+		if !IsWaffleTile(index) {
+			grid += WaffleHoleChar
+			resultGrid += WaffleHoleChar
+			continue
+		}
+		if !strings.Contains(Alphabet, letters[tile:tile+1]) {
+			return "", "", fmt.Errorf("'%s' is not a letter", letters[tile:tile+1])
+		}
+		if !strings.Contains(MatchedChar+WildcardChar+MissedChar, results[tile:tile+1]) {
+			return "", "", fmt.Errorf("'%s' must be one of '%s', '%s' or '%s'", results[tile:tile+1], MatchedChar, WildcardChar, MissedChar)
+		}
+		grid += letters[tile : tile+1]
+		resultGrid += results[tile : tile+1]
+		tile++
+	}
+	return grid, resultGrid, nil
+}
+
+// SolveWaffle returns up to maxSolutions grids of words that match the letters and results.
+func SolveWaffle(words []string, grid string, resultGrid string, maxSolutions int) []string {
+	slots := GetWaffleSlots()
+	candidates := [][]string{}
+	for _, slot := range slots {
+		slotCandidates := []string{}
+		for _, word := range words {
+			if len(word) == WaffleSize && isWaffleSlotMatch(word, slot, grid, resultGrid) {
+				slotCandidates = append(slotCandidates, word)
+			}
+		}
+		candidates = append(candidates, slotCandidates)
+	}
+
+	available := map[byte]int{}
+	for i := 0; i < len(grid); i++ {
+		if IsWaffleTile(i) {
+			available[grid[i]]++
+		}
+	}
+
+	solutions := []string{}
+	solution := []byte(grid)
+	filled := make([]bool, len(grid))
+	var solveSlot func(slotIndex int)
+	solveSlot = func(slotIndex int) {
+		if maxSolutions > 0 && len(solutions) >= maxSolutions {
+			return
+		}
+		if slotIndex == len(slots) {
+			solutions = append(solutions, string(solution))
+			return
+		}
+		slot := slots[slotIndex]
+		for _, word := range candidates[slotIndex] {
+			// Letters where a row crosses a column must be the same in both words.
+			placed := []int{}
+			fits := true
+			for k, index := range slot {
+				if filled[index] {
+					if solution[index] != word[k] {
+						fits = false
+						break
+					}
+					continue
+				}
+				if available[word[k]] == 0 {
+					fits = false
+					break
+				}
+				available[word[k]]--
+				solution[index] = word[k]
+				filled[index] = true
+				placed = append(placed, index)
+			}
+			if fits {
+				solveSlot(slotIndex + 1)
+			}
+			for _, index := range placed {
+				available[solution[index]]++
+				filled[index] = false
+			}
+		}
+	}
+	solveSlot(0)
+
+	return solutions
+}
+
+// isWaffleSlotMatch checks a word against the results of the tiles in its row or column. A tile where a row and
+// column cross could be yellow because of either word, so only tiles in a single word are used for yellow and grey.
+func isWaffleSlotMatch(word string, slot []int, grid string, resultGrid string) bool {
+	yellowLetters := map[byte]int{}
+	for _, index := range slot {
+		if resultGrid[index:index+1] == WildcardChar {
+			yellowLetters[grid[index]]++
+		}
+	}
+	unknownLetters := map[byte]int{}
+	for k, index := range slot {
+		if resultGrid[index:index+1] == MatchedChar {
+			if word[k] != grid[index] {
+				return false
+			}
+			continue
+		}
+		if word[k] == grid[index] {
+			return false
+		}
+		unknownLetters[word[k]]++
+	}
+
+	for k, index := range slot {
+		if isWaffleCrossing(index) {
+			continue
+		}
+		letter := grid[index]
+		switch resultGrid[index : index+1] {
+		case WildcardChar:
+			if !strings.Contains(word[:k]+word[k+1:], string(letter)) {
+				return false
+			}
+		case MissedChar:
+			if unknownLetters[letter] > yellowLetters[letter] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isWaffleCrossing(index int) bool {
+	return (index/WaffleSize)%2 == 0 && (index%WaffleSize)%2 == 0
+}
+
+// GetWaffleSwaps returns the fewest swaps that turn the grid into the solution.
+func GetWaffleSwaps(grid string, solution string) []WaffleSwap {
+	wrongTiles := []int{}
+	for index := 0; index < len(grid); index++ {
+		if grid[index] != solution[index] {
+			wrongTiles = append(wrongTiles, index)
+		}
+	}
+
+	// The wrong tiles form cycles where a cycle of n tiles takes n-1 swaps, so the fewest swaps use the most cycles.
+	// Repeated letters can make many different cycles, so every way of splitting the tiles is searched.
+	mostCycles := map[uint32]int{}
+	bestCycle := map[uint32][]int{}
+	var findCycles func(remaining uint32) int
+	findCycles = func(remaining uint32) int {
+		if remaining == 0 {
+			return 0
+		}
+		if cycles, found := mostCycles[remaining]; found {
+			return cycles
+		}
+		first := 0
+		for remaining&(1<<uint(first)) == 0 {
+			first++
+		}
+		cycles := -1
+		var extendCycle func(cycle []int, used uint32)
+		extendCycle = func(cycle []int, used uint32) {
+			last := wrongTiles[cycle[len(cycle)-1]]
+			if solution[last] == grid[wrongTiles[first]] && len(cycle) > 1 {
+				if count := 1 + findCycles(remaining&^used); count > cycles {
+					cycles = count
+					bestCycle[remaining] = append([]int{}, cycle...)
+				}
+			}
+			for next := range wrongTiles {
+				bit := uint32(1) << uint(next)
+				if remaining&bit != 0 && used&bit == 0 && grid[wrongTiles[next]] == solution[last] {
+					extendCycle(append(cycle, next), used|bit)
+				}
+			}
+		}
+		extendCycle([]int{first}, 1<<uint(first))
+		mostCycles[remaining] = cycles
+		return cycles
+	}
+
+	remaining := uint32(1)<<uint(len(wrongTiles)) - 1
+	if findCycles(remaining) < 0 {
+		// The grid and solution do not have the same letters.
+		return nil
+	}
+
+	swaps := []WaffleSwap{}
+	for remaining != 0 {
+		cycle := bestCycle[remaining]
+		for k := 0; k < len(cycle)-1; k++ {
+			swaps = append(swaps, WaffleSwap{From: wrongTiles[cycle[k]], To: wrongTiles[cycle[k+1]]})
+		}
+		for _, tile := range cycle {
+			remaining &^= 1 << uint(tile)
+		}
+	}
+	return swaps
+}
+
+// ApplyWaffleSwaps returns the grid after each of the swaps.
+func ApplyWaffleSwaps(grid string, swaps []WaffleSwap) string {
+	tiles := []byte(grid)
+	for _, swap := range swaps {
+		tiles[swap.From], tiles[swap.To] = tiles[swap.To], tiles[swap.From]
+	}
+	return string(tiles)
+}
@@ -0,0 +1,101 @@
+package words
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseWaffle(t *testing.T) {
+	tests := []struct {
+		name           string
+		letters        string
+		results        string
+		wantGrid       string
+		wantResultGrid string
+		wantErr        bool
+	}{
+		{
+			name:           "Valid Grid",
+			letters:        "CBADEFGHIJSLKNOPQRMTU",
+			results:        "-=-==" + "===" + "==-=-" + "===" + "==x==",
+			wantGrid:       "cbade" + "f g h" + "ijslk" + "n o p" + "qrmtu",
+			wantResultGrid: "-=-==" + "= = =" + "==-=-" + "= = =" + "==x==",
+		},
+		{
+			name:    "Too Few Letters",
+			letters: "cbade",
+			results: "-=-==" + "===" + "==-=-" + "===" + "==x==",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid Result",
+			letters: "cbadefghijslknopqrmtu",
+			results: "-=-==" + "===" + "==-=-" + "===" + "==?==",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotGrid, gotResultGrid, err := ParseWaffle(tt.letters, tt.results)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseWaffle() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if gotGrid != tt.wantGrid {
+				t.Errorf("ParseWaffle() gotGrid = '%v', want '%v'", gotGrid, tt.wantGrid)
+			}
+			if gotResultGrid != tt.wantResultGrid {
+				t.Errorf("ParseWaffle() gotResultGrid = '%v', want '%v'", gotResultGrid, tt.wantResultGrid)
+			}
+		})
+	}
+}
+
+func TestSolveWaffle(t *testing.T) {
+	words := []string{"abcde", "ijklm", "qrstu", "afinq", "cgkos", "ehmpu", "abmde", "ijkls", "cbade"}
+	grid := "cbade" + "f g h" + "ijslk" + "n o p" + "qrmtu"
+	resultGrid := "-=-==" + "= = =" + "==-=-" + "= = =" + "==x=="
+	want := []string{"abcde" + "f g h" + "ijklm" + "n o p" + "qrstu"}
+	if got := SolveWaffle(words, grid, resultGrid, 2); !reflect.DeepEqual(got, want) {
+		t.Errorf("SolveWaffle() = %v, want %v", got, want)
+	}
+}
+
+func TestGetWaffleSwaps(t *testing.T) {
+	tests := []struct {
+		name      string
+		grid      string
+		solution  string
+		wantSwaps int
+	}{
+		{
+			name:      "Solved",
+			grid:      "abcde" + "f g h" + "ijklm" + "n o p" + "qrstu",
+			solution:  "abcde" + "f g h" + "ijklm" + "n o p" + "qrstu",
+			wantSwaps: 0,
+		},
+		{
+			name:      "Two And Three Tile Cycles",
+			grid:      "cbade" + "f g h" + "ijslk" + "n o p" + "qrmtu",
+			solution:  "abcde" + "f g h" + "ijklm" + "n o p" + "qrstu",
+			wantSwaps: 3,
+		},
+		{
+			name:      "Repeated Letters",
+			grid:      "eabce" + "a a a" + "cbeae" + "b b c" + "aecbe",
+			solution:  "aabce" + "e a b" + "cbeae" + "a b c" + "eecba",
+			wantSwaps: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			swaps := GetWaffleSwaps(tt.grid, tt.solution)
+			if len(swaps) != tt.wantSwaps {
+				t.Errorf("GetWaffleSwaps() = %v swaps, want %v", len(swaps), tt.wantSwaps)
+			}
+			if got := ApplyWaffleSwaps(tt.grid, swaps); got != tt.solution {
+				t.Errorf("ApplyWaffleSwaps() = '%v', want '%v'", got, tt.solution)
+			}
+		})
+	}
+}
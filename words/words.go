@@ -10,8 +10,33 @@ const (
 	WildcardChar = "-"
 	MatchedChar  = "="
 	MissedChar   = "x"
+	UnknownChar  = "?" // Result for a tile whose colour is not known, which could be any of the other results.
 )
 
+// IsResultMatch reports whether result matches shownResult, where an UnknownChar matches any result.
+func IsResultMatch(result string, shownResult string) bool {
+	if len(result) != len(shownResult) {
+		return false
+	}
+	for i := 0; i < len(result); i++ {
+		if result[i] != shownResult[i] && shownResult[i:i+1] != UnknownChar {
+			return false
+		}
+	}
+	return true
+}
+
+// isLetterFoundElsewhere reports whether another instance of the letter in the guess was, or could have been, found
+// in the word.
+func isLetterFoundElsewhere(guess string, results string, position int) bool {
+	for j := range results {
+		if j != position && guess[j] == guess[position] && (string(results[j]) == MatchedChar || string(results[j]) == WildcardChar || string(results[j]) == UnknownChar) {
+			return true
+		}
+	}
+	return false
+}
+
 func WordMatch(
 	word string,
 	wordPattern string,
@@ -113,6 +138,10 @@ func GetLetterCount(words []string, wordPattern string, wildcardLetters string)
 		keys = append(keys, k)
 	}
 	sort.Slice(keys, func(i, j int) bool {
+		if letterCount[keys[i]] == letterCount[keys[j]] {
+			// Break ties alphabetically so the same words always give the same suggestions.
+			return keys[i] < keys[j]
+		}
 		return letterCount[keys[i]] > letterCount[keys[j]]
 	})
 
@@ -124,6 +153,26 @@ func GetLetterCount(words []string, wordPattern string, wildcardLetters string)
 	return letterCount, letterOrdering
 }
 
+// GetLetterStates returns the result character that is known for each letter.
+func GetLetterStates(wordPattern string, wildcardLetters string, excludedLetters string) map[string]string {
+	letterStates := map[string]string{}
+
+	// Letters known to be in a position take precedence over letters only known to be in the word.
+	for _, letter := range excludedLetters {
+		letterStates[string(letter)] = MissedChar
+	}
+	for _, letter := range wildcardLetters {
+		letterStates[string(letter)] = WildcardChar
+	}
+	for _, letter := range wordPattern {
+		if string(letter) != WildcardChar {
+			letterStates[string(letter)] = MatchedChar
+		}
+	}
+
+	return letterStates
+}
+
 func GetLetterDistribution(words []string, wordLength int) []map[string]int {
 	letterDistribution := []map[string]int{}
 
@@ -149,8 +198,6 @@ func GetEliminationWords(
 	wildcardLetters string,
 	excludedByPosMap map[int]string) []string {
 
-	fmt.Printf("\nTrying elimination letters: '%s'\n", eliminationLetters)
-
 	return GetMatchingWords(words, strings.Repeat(WildcardChar, wordLength), "", eliminationLetters, false, map[int]string{})
 }
 
@@ -187,14 +234,16 @@ func GetBestEliminationWords(words []string, eliminationWords []string, wordLeng
 			}
 		}
 
-		// Check for other letters with the same letter count.
+		// Check for other letters with the same letter count, in alphabetical order so the letters are always the same.
 		count := letterCounts[string(letter)]
-		letters := string(letter)
+		tiedLetters := []string{}
 		for letterKey, letterCount := range letterCounts {
 			if letterKey != string(letter) && letterCount == count {
-				letters = letters + letterKey
+				tiedLetters = append(tiedLetters, letterKey)
 			}
 		}
+		sort.Strings(tiedLetters)
+		letters := string(letter) + strings.Join(tiedLetters, "")
 		lastLetters = letters
 		if debug {
 			if len(letters) > 1 {
@@ -256,6 +305,9 @@ func GetBestEliminationWords(words []string, eliminationWords []string, wordLeng
 			keys = append(keys, k)
 		}
 		sort.Slice(keys, func(i, j int) bool {
+			if eliminationLettersCount[keys[i]] == eliminationLettersCount[keys[j]] {
+				return keys[i] < keys[j]
+			}
 			return eliminationLettersCount[keys[i]] > eliminationLettersCount[keys[j]]
 		})
 
@@ -291,6 +343,9 @@ func GetBestEliminationWords(words []string, eliminationWords []string, wordLeng
 			keys = append(keys, k)
 		}
 		sort.Slice(keys, func(i, j int) bool {
+			if eliminationLettersScore[keys[i]] == eliminationLettersScore[keys[j]] {
+				return keys[i] < keys[j]
+			}
 			return eliminationLettersScore[keys[i]] > eliminationLettersScore[keys[j]]
 		})
 
@@ -349,6 +404,9 @@ func GetBestEliminationWords(words []string, eliminationWords []string, wordLeng
 			keys = append(keys, k)
 		}
 		sort.Slice(keys, func(i, j int) bool {
+			if eliminationWordScore[keys[i]] == eliminationWordScore[keys[j]] {
+				return keys[i] < keys[j]
+			}
 			return eliminationWordScore[keys[i]] > eliminationWordScore[keys[j]]
 		})
 
@@ -416,14 +474,7 @@ func TranslateGuessResults(
 				}
 			case MissedChar:
 				// Can be another instance of an exsiting letter.
-				matchLater := false
-				for j := i + 1; j < len(results); j++ {
-					if string(guess[j]) == guessLetter && (string(results[j]) == MatchedChar || string(results[j]) == WildcardChar) {
-						matchLater = true
-						break
-					}
-				}
-				if !matchLater && !strings.Contains(wordPattern, guessLetter) && !strings.Contains(wildcardLetters, guessLetter) {
+				if !isLetterFoundElsewhere(guess, results, i) && !strings.Contains(wordPattern, guessLetter) && !strings.Contains(wildcardLetters, guessLetter) {
 					if !strings.Contains(excludedLetters, guessLetter) {
 						excludedLetters += guessLetter
 					}
@@ -437,3 +488,74 @@ func TranslateGuessResults(
 	}
 	return wordPattern, wildcardLetters, excludedLetters, excludedByPosMap
 }
+
+// TranslateAllGuessResults adds the results of every guess to a copy of the constraints.
+func TranslateAllGuessResults(
+	guesses []string,
+	results []string,
+	wordPattern string,
+	excludedLetters string,
+	wildcardLetters string,
+	excludedByPosMap map[int]string) (string, string, string, map[int]string) {
+
+	// Start from a copy so the starting constraints can be used again.
+	translatedByPosMap := make(map[int]string)
+	for pos, letters := range excludedByPosMap {
+		translatedByPosMap[pos] = letters
+	}
+	for i := range guesses {
+		if i < len(results) {
+			wordPattern, wildcardLetters, excludedLetters, translatedByPosMap = TranslateGuessResults(guesses[i], results[i], wordPattern, excludedLetters, wildcardLetters, translatedByPosMap)
+		}
+	}
+	return wordPattern, wildcardLetters, excludedLetters, translatedByPosMap
+}
+
+// GetConflicts describes each part of a result that contradicts the known constraints.
+func GetConflicts(
+	guess string,
+	results string,
+	wordPattern string,
+	excludedLetters string,
+	wildcardLetters string,
+	excludedByPosMap map[int]string) []string {
+
+	conflicts := []string{}
+	if len(guess) != len(results) || len(guess) != len(wordPattern) {
+		return conflicts
+	}
+
+	for i := range results {
+		guessLetter := string(guess[i])
+		patternLetter := string(wordPattern[i])
+		position := i + 1
+		switch string(results[i]) {
+		case MatchedChar:
+			if patternLetter != WildcardChar && patternLetter != guessLetter {
+				conflicts = append(conflicts, fmt.Sprintf("'%s' matches position #%d, but '%s' is already known to be in position #%d.", guessLetter, position, patternLetter, position))
+			} else if strings.Contains(excludedByPosMap[position], guessLetter) {
+				conflicts = append(conflicts, fmt.Sprintf("'%s' matches position #%d, but '%s' is already known to not be in position #%d.", guessLetter, position, guessLetter, position))
+			}
+			if strings.Contains(excludedLetters, guessLetter) {
+				conflicts = append(conflicts, fmt.Sprintf("'%s' matches position #%d, but '%s' is already known to not be in the word.", guessLetter, position, guessLetter))
+			}
+		case WildcardChar:
+			if patternLetter == guessLetter {
+				conflicts = append(conflicts, fmt.Sprintf("'%s' is out of position #%d, but '%s' is already known to be in position #%d.", guessLetter, position, guessLetter, position))
+			}
+			if strings.Contains(excludedLetters, guessLetter) {
+				conflicts = append(conflicts, fmt.Sprintf("'%s' is in the word, but '%s' is already known to not be in the word.", guessLetter, guessLetter))
+			}
+		case MissedChar:
+			if patternLetter == guessLetter {
+				conflicts = append(conflicts, fmt.Sprintf("'%s' does not match position #%d, but '%s' is already known to be in position #%d.", guessLetter, position, guessLetter, position))
+			} else if strings.Contains(wordPattern, guessLetter) || strings.Contains(wildcardLetters, guessLetter) {
+				// Only a conflict if no other instance of the letter in the guess was found in the word.
+				if !isLetterFoundElsewhere(guess, results, i) {
+					conflicts = append(conflicts, fmt.Sprintf("'%s' is not in the word, but '%s' is already known to be in the word.", guessLetter, guessLetter))
+				}
+			}
+		}
+	}
+	return conflicts
+}
@@ -135,6 +135,37 @@ func Test_getMatchingWords(t *testing.T) {
 	}
 }
 
+func TestGetLetterStates(t *testing.T) {
+	type args struct {
+		wordPattern     string
+		wildcardLetters string
+		excludedLetters string
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]string
+	}{
+		{
+			name: "Nothing Known",
+			args: args{wordPattern: "-----"},
+			want: map[string]string{},
+		},
+		{
+			name: "Matched, Wildcard and Excluded Letters",
+			args: args{wordPattern: "--e--", wildcardLetters: "ratev", excludedLetters: "oflck"},
+			want: map[string]string{"e": "=", "r": "-", "a": "-", "t": "-", "v": "-", "o": "x", "f": "x", "l": "x", "c": "x", "k": "x"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetLetterStates(tt.args.wordPattern, tt.args.wildcardLetters, tt.args.excludedLetters); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetLetterStates() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestTranslateGuessResults(t *testing.T) {
 	type args struct {
 		guess            string
@@ -184,6 +215,22 @@ func TestTranslateGuessResults(t *testing.T) {
 			wantExcludedLetters:  "k",
 			wantExcludedByPosMap: map[int]string{1: "c"},
 		},
+		{
+			name:                 "Unknown Tiles",
+			args:                 args{guess: "abcde", results: "=?x?-", wordPattern: "-----", excludedLetters: "", wildcardLetters: "", excludedByPosMap: map[int]string{}},
+			wantWordPattern:      "a----",
+			wantWildcardLetters:  "e",
+			wantExcludedLetters:  "c",
+			wantExcludedByPosMap: map[int]string{5: "e"},
+		},
+		{
+			name:                 "Repeating Letter Unknown Earlier In Word",
+			args:                 args{guess: "chick", results: "?==xx", wordPattern: "-----", excludedLetters: "", wildcardLetters: "", excludedByPosMap: map[int]string{}},
+			wantWordPattern:      "-hi--",
+			wantWildcardLetters:  "",
+			wantExcludedLetters:  "k",
+			wantExcludedByPosMap: map[int]string{4: "c"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -203,3 +250,209 @@ func TestTranslateGuessResults(t *testing.T) {
 		})
 	}
 }
+
+func TestTranslateAllGuessResults(t *testing.T) {
+	type args struct {
+		guesses          []string
+		results          []string
+		wordPattern      string
+		excludedLetters  string
+		wildcardLetters  string
+		excludedByPosMap map[int]string
+	}
+	tests := []struct {
+		name                 string
+		args                 args
+		wantWordPattern      string
+		wantWildcardLetters  string
+		wantExcludedLetters  string
+		wantExcludedByPosMap map[int]string
+	}{
+		{
+			name:                 "No Guesses",
+			args:                 args{guesses: []string{}, results: []string{}, wordPattern: "t----", excludedLetters: "ies", wildcardLetters: "r", excludedByPosMap: map[int]string{2: "r"}},
+			wantWordPattern:      "t----",
+			wantWildcardLetters:  "r",
+			wantExcludedLetters:  "ies",
+			wantExcludedByPosMap: map[int]string{2: "r"},
+		},
+		{
+			name:                 "Multiple Guesses",
+			args:                 args{guesses: []string{"roate", "fleck", "gived"}, results: []string{"-x---", "xx=xx", "xx--x"}, wordPattern: "-----", excludedLetters: "", wildcardLetters: "", excludedByPosMap: map[int]string{}},
+			wantWordPattern:      "--e--",
+			wantWildcardLetters:  "ratev",
+			wantExcludedLetters:  "oflckgid",
+			wantExcludedByPosMap: map[int]string{1: "r", 3: "av", 4: "te", 5: "e"},
+		},
+		{
+			name:                 "Unknown Results Keep Earlier Results",
+			args:                 args{guesses: []string{"roate", "tread"}, results: []string{"-x---", "?????"}, wordPattern: "-----", excludedLetters: "", wildcardLetters: "", excludedByPosMap: map[int]string{}},
+			wantWordPattern:      "-----",
+			wantWildcardLetters:  "rate",
+			wantExcludedLetters:  "o",
+			wantExcludedByPosMap: map[int]string{1: "r", 3: "a", 4: "t", 5: "e"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			startingByPosMap := map[int]string{}
+			for pos, letters := range tt.args.excludedByPosMap {
+				startingByPosMap[pos] = letters
+			}
+			gotWordPattern, gotWildcardLetters, gotExcludedLetters, gotExcludedByPosMap := TranslateAllGuessResults(tt.args.guesses, tt.args.results, tt.args.wordPattern, tt.args.excludedLetters, tt.args.wildcardLetters, tt.args.excludedByPosMap)
+			if gotWordPattern != tt.wantWordPattern {
+				t.Errorf("TranslateAllGuessResults() gotWordPattern = %v, want %v", gotWordPattern, tt.wantWordPattern)
+			}
+			if gotWildcardLetters != tt.wantWildcardLetters {
+				t.Errorf("TranslateAllGuessResults() gotWildcardLetters = %v, want %v", gotWildcardLetters, tt.wantWildcardLetters)
+			}
+			if gotExcludedLetters != tt.wantExcludedLetters {
+				t.Errorf("TranslateAllGuessResults() gotExcludedLetters = %v, want %v", gotExcludedLetters, tt.wantExcludedLetters)
+			}
+			if !reflect.DeepEqual(gotExcludedByPosMap, tt.wantExcludedByPosMap) {
+				t.Errorf("TranslateAllGuessResults() gotExcludedByPosMap = %v, want %v", gotExcludedByPosMap, tt.wantExcludedByPosMap)
+			}
+			if !reflect.DeepEqual(tt.args.excludedByPosMap, startingByPosMap) {
+				t.Errorf("TranslateAllGuessResults() modified excludedByPosMap = %v, want %v", tt.args.excludedByPosMap, startingByPosMap)
+			}
+		})
+	}
+}
+
+func TestGetConflicts(t *testing.T) {
+	type args struct {
+		guess            string
+		results          string
+		wordPattern      string
+		excludedLetters  string
+		wildcardLetters  string
+		excludedByPosMap map[int]string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "No Conflicts",
+			args: args{guess: "fleck", results: "xx=xx", wordPattern: "-----", excludedLetters: "o", wildcardLetters: "rate", excludedByPosMap: map[int]string{1: "r", 3: "a", 4: "t", 5: "e"}},
+			want: []string{},
+		},
+		{
+			name: "Repeating Letter Is Not A Conflict",
+			args: args{guess: "eerie", results: "=xxxx", wordPattern: "e----", excludedLetters: "", wildcardLetters: "", excludedByPosMap: map[int]string{}},
+			want: []string{},
+		},
+		{
+			name: "Different Letter Matches Known Position",
+			args: args{guess: "fleck", results: "xx=xx", wordPattern: "--a--", excludedLetters: "", wildcardLetters: "", excludedByPosMap: map[int]string{}},
+			want: []string{"'e' matches position #3, but 'a' is already known to be in position #3."},
+		},
+		{
+			name: "Excluded Letter In Word",
+			args: args{guess: "fleck", results: "x-=xx", wordPattern: "-----", excludedLetters: "le", wildcardLetters: "", excludedByPosMap: map[int]string{}},
+			want: []string{"'l' is in the word, but 'l' is already known to not be in the word.", "'e' matches position #3, but 'e' is already known to not be in the word."},
+		},
+		{
+			name: "Matched Letter Excluded By Position",
+			args: args{guess: "fleck", results: "xx=xx", wordPattern: "-----", excludedLetters: "", wildcardLetters: "e", excludedByPosMap: map[int]string{3: "e"}},
+			want: []string{"'e' matches position #3, but 'e' is already known to not be in position #3."},
+		},
+		{
+			name: "Known Position No Longer Matched",
+			args: args{guess: "fleck", results: "xx-xx", wordPattern: "--e--", excludedLetters: "", wildcardLetters: "", excludedByPosMap: map[int]string{}},
+			want: []string{"'e' is out of position #3, but 'e' is already known to be in position #3."},
+		},
+		{
+			name: "Known Position Missed",
+			args: args{guess: "fleck", results: "xxxxx", wordPattern: "--e--", excludedLetters: "", wildcardLetters: "", excludedByPosMap: map[int]string{}},
+			want: []string{"'e' does not match position #3, but 'e' is already known to be in position #3."},
+		},
+		{
+			name: "Known Letter Not In Word",
+			args: args{guess: "ovate", results: "=xxxx", wordPattern: "-----", excludedLetters: "", wildcardLetters: "e", excludedByPosMap: map[int]string{5: "e"}},
+			want: []string{"'e' is not in the word, but 'e' is already known to be in the word."},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetConflicts(tt.args.guess, tt.args.results, tt.args.wordPattern, tt.args.excludedLetters, tt.args.wildcardLetters, tt.args.excludedByPosMap); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetConflicts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsResultMatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		result      string
+		shownResult string
+		want        bool
+	}{
+		{name: "Same", result: "=-x==", shownResult: "=-x==", want: true},
+		{name: "Different", result: "=-x==", shownResult: "=xx==", want: false},
+		{name: "Unknown Tile", result: "=-x==", shownResult: "=?x=?", want: true},
+		{name: "Different Length", result: "=-x==", shownResult: "=?x=", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsResultMatch(tt.result, tt.shownResult); got != tt.want {
+				t.Errorf("IsResultMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetLetterCountTies(t *testing.T) {
+	tests := []struct {
+		name            string
+		words           []string
+		wordPattern     string
+		wantOrdering    string
+		wantLetterCount map[string]int
+	}{
+		{
+			name:            "Most Letters First",
+			words:           []string{"bat", "cat", "cot"},
+			wordPattern:     "--t",
+			wantOrdering:    "acbo",
+			wantLetterCount: map[string]int{"a": 2, "b": 1, "c": 2, "o": 1},
+		},
+		{
+			name:            "Ties in Alphabetical Order",
+			words:           []string{"hcb", "gfd", "eai"},
+			wordPattern:     "---",
+			wantOrdering:    "abcdefghi",
+			wantLetterCount: map[string]int{"a": 1, "b": 1, "c": 1, "d": 1, "e": 1, "f": 1, "g": 1, "h": 1, "i": 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Map iteration is random, so check more than once that the order does not change.
+			for i := 0; i < 20; i++ {
+				gotLetterCount, gotOrdering := GetLetterCount(tt.words, tt.wordPattern, "")
+				if gotOrdering != tt.wantOrdering {
+					t.Fatalf("GetLetterCount() gotOrdering = %v, want %v", gotOrdering, tt.wantOrdering)
+				}
+				if !reflect.DeepEqual(gotLetterCount, tt.wantLetterCount) {
+					t.Fatalf("GetLetterCount() gotLetterCount = %v, want %v", gotLetterCount, tt.wantLetterCount)
+				}
+			}
+		})
+	}
+}
+
+func TestGetBestEliminationWordsTies(t *testing.T) {
+	words := []string{"bat", "cat", "hat"}
+	letterCounts, eliminationLetters := GetLetterCount(words, "-at", "")
+	letterDistribution := GetLetterDistribution(words, 3)
+	want := []string{"bch", "chb", "hcb"}
+	// Map iteration is random, so check more than once that the order does not change.
+	for i := 0; i < 20; i++ {
+		got := GetBestEliminationWords(words, []string{"hcb", "chb", "bch"}, 3, eliminationLetters, letterCounts, letterDistribution, false)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("GetBestEliminationWords() = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package words
+
+import (
+	"strings"
+)
+
+const XordlePairSeparator = "+"
+
+// resultRank orders the result characters from the least to the most matched.
+var resultRank = map[byte]int{MissedChar[0]: 0, WildcardChar[0]: 1, MatchedChar[0]: 2}
+
+// ScoreXordle returns the best of the results for guess against the two answers, such as 'avert+pilly'.
+func ScoreXordle(answerPair string, guess string) string {
+	answers := strings.SplitN(answerPair, XordlePairSeparator, 2)
+	if len(answers) != 2 {
+		return ScoreGuess(answerPair, guess)
+	}
+	result := []byte(ScoreGuess(answers[0], guess))
+	otherResult := ScoreGuess(answers[1], guess)
+	if len(result) != len(otherResult) {
+		return ""
+	}
+	for i := range result {
+		if resultRank[otherResult[i]] > resultRank[result[i]] {
+			result[i] = otherResult[i]
+		}
+	}
+	return string(result)
+}
+
+// HasDisjointLetters reports whether the two words do not have any letters in common.
+func HasDisjointLetters(word string, otherWord string) bool {
+	return !strings.ContainsAny(word, otherWord)
+}
+
+// isXordleWordPossible reports whether word could be one of the two answers, where no tile of its own result can be
+// better than the tile that was shown. Tiles with an UnknownChar could be any result.
+func isXordleWordPossible(word string, guesses []string, results []string) bool {
+	for i, guess := range guesses {
+		if len(word) != len(guess) {
+			return false
+		}
+		wordResult := ScoreGuess(word, guess)
+		for j := 0; j < len(wordResult); j++ {
+			if results[i][j:j+1] != UnknownChar && resultRank[wordResult[j]] > resultRank[results[i][j]] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// GetXordlePairs returns the pairs of words with no letters in common that match every result.
+func GetXordlePairs(words []string, guesses []string, results []string) []string {
+	possibleWords := []string{}
+	for _, word := range words {
+		if isXordleWordPossible(word, guesses, results) {
+			possibleWords = append(possibleWords, word)
+		}
+	}
+
+	var pairs []string
+	for i, word := range possibleWords {
+		for _, otherWord := range possibleWords[i+1:] {
+			if !HasDisjointLetters(word, otherWord) {
+				continue
+			}
+			pair := word + XordlePairSeparator + otherWord
+			match := true
+			for j, guess := range guesses {
+				if !IsResultMatch(ScoreXordle(pair, guess), results[j]) {
+					match = false
+					break
+				}
+			}
+			if match {
+				pairs = append(pairs, pair)
+			}
+		}
+	}
+	return pairs
+}
+
+// GetBestXordleGuesses sorts the guess words by the expected number of pairs left after each one, fewest first.
+func GetBestXordleGuesses(pairs []string, guessWords []string) ([]string, map[string]float64) {
+	return GetBestScoredGuesses(pairs, guessWords, ScoreXordle)
+}
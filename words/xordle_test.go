@@ -0,0 +1,71 @@
+package words
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestScoreXordle(t *testing.T) {
+	tests := []struct {
+		name       string
+		answerPair string
+		guess      string
+		want       string
+	}{
+		{
+			name:       "Best Of Both Results",
+			answerPair: "avert+pilly",
+			guess:      "plait",
+			want:       "=---=",
+		},
+		{
+			name:       "Guessed One Answer",
+			answerPair: "avert+pilly",
+			guess:      "pilly",
+			want:       "=====",
+		},
+		{
+			name:       "Single Answer",
+			answerPair: "avert",
+			guess:      "roate",
+			want:       "-x---",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ScoreXordle(tt.answerPair, tt.guess); got != tt.want {
+				t.Errorf("ScoreXordle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetXordlePairs(t *testing.T) {
+	words := []string{"avert", "pilly", "alert", "bumpy", "clump"}
+	tests := []struct {
+		name    string
+		guesses []string
+		results []string
+		want    []string
+	}{
+		{
+			name:    "Disjoint Pairs",
+			guesses: []string{},
+			results: []string{},
+			want:    []string{"avert+pilly", "avert+bumpy", "avert+clump", "alert+bumpy"},
+		},
+		{
+			name:    "Filtered By Result",
+			guesses: []string{"plait"},
+			results: []string{ScoreXordle("avert+pilly", "plait")},
+			want:    []string{"avert+pilly"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetXordlePairs(words, tt.guesses, tt.results); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetXordlePairs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
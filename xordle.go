@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"wordtl/words"
+)
+
+// MaxScoredXordlePairs limits how many pairs are scored to suggest the next guess. When there are more, an evenly
+// spaced sample of the pairs is used.
+const MaxScoredXordlePairs = 2000
+
+// Xordle solves Xordle, where the two answers share no letters and each result is colored against both of them.
+func Xordle(solutionWords []string, allWords []string) {
+	guesses, results := getGuessesAndResults()
+
+	pairs := words.GetXordlePairs(solutionWords, guesses, results)
+	printWords(pairs, "MATCHING PAIRS", "SOLUTION", MaxWordsToPrint)
+	if len(pairs) <= 1 {
+		fmt.Println()
+		return
+	}
+
+	scoredPairs := pairs
+	if len(pairs) > MaxScoredXordlePairs {
+		fmt.Printf("\nSuggesting guesses from a sample of %d of the %d pairs.\n", MaxScoredXordlePairs, len(pairs))
+		scoredPairs = make([]string, 0, MaxScoredXordlePairs)
+		for i := 0; i < MaxScoredXordlePairs; i++ {
+			scoredPairs = append(scoredPairs, pairs[i*len(pairs)/MaxScoredXordlePairs])
+		}
+	}
+
+	bestGuesses, expectedRemaining := words.GetBestXordleGuesses(scoredPairs, getXordleGuessWords(scoredPairs, allWords))
+	printBestGuesses(bestGuesses, expectedRemaining)
+}
+
+// getXordleGuessWords falls back to the words in the pairs when there are too many pairs to score every word.
+func getXordleGuessWords(pairs []string, allWords []string) []string {
+	if len(pairs)*len(allWords) <= MaxScoredGuessChecks {
+		return allWords
+	}
+	pairWords := []string{}
+	seen := map[string]bool{}
+	for _, pair := range pairs {
+		for _, word := range strings.Split(pair, words.XordlePairSeparator) {
+			if !seen[word] {
+				seen[word] = true
+				pairWords = append(pairWords, word)
+			}
+		}
+	}
+	return pairWords
+}